@@ -5,12 +5,12 @@ provider "litellm" {
 
 # Example: using additional_litellm_params to pass provider-specific options.
 # Notes:
-# - String values "true"/"false" will be coerced to booleans.
-# - Numeric strings will be parsed to integer (if possible) otherwise float.
-# - JSON strings (starting with [ or {) will be parsed as JSON objects/arrays.
-# - Non-convertible strings remain strings.
-# - Non-string map values are passed through unchanged.
-# - Use "additional_drop_params" as a JSON array to remove parameters from the final request.
+# - Values keep their type: numbers, booleans, lists and nested objects are sent
+#   to the proxy as such.
+# - Keys set from other arguments (e.g. api_base, tpm, aws_region_name) are
+#   rejected; use the dedicated argument instead.
+# - Use "additional_drop_params" to remove parameters from requests the proxy
+#   sends to the provider.
 
 resource "litellm_model" "with_additional" {
   model_name          = "custom-model"
@@ -21,14 +21,13 @@ resource "litellm_model" "with_additional" {
 
   # Additional parameters not exposed as first-class arguments
   additional_litellm_params = {
-    "use_fine_tune"          = "true"                           # becomes boolean true
-    "max_context"            = "16384"                          # becomes integer 16384
-    "temperature_scale"      = "0.75"                           # becomes float 0.75
-    "experimental_feature"   = "enabled"                        # stays string "enabled"
-    "complex_config"         = "{\"nested\": {\"value\": 42}}"  # parsed as JSON object
-    "additional_drop_params" = "[\"reasoningEffort\"]"          # removes reasoningEffort parameter
-    # You may also pass non-string values (they will be passed through unchanged)
-    # "raw_flag" = true
+    max_retries    = 3
+    stream_timeout = 30.5
+    timeout        = 600
+    drop_params    = true
+    extra_headers = {
+      "x-request-source" = "terraform"
+    }
   }
 
   # Cost configuration (optional)
@@ -36,6 +35,24 @@ resource "litellm_model" "with_additional" {
   output_cost_per_million_tokens = 60.0
 }
 
+# Example: Bedrock cross-region inference profile with retry settings
+resource "litellm_model" "bedrock_cross_region" {
+  model_name          = "claude-sonnet"
+  custom_llm_provider = "bedrock"
+  base_model          = "us.anthropic.claude-3-5-sonnet-20241022-v2:0"
+  mode                = "chat"
+
+  bedrock {
+    aws_region_name              = "us-east-1"
+    aws_bedrock_runtime_endpoint = "https://bedrock-runtime.us-east-1.amazonaws.com"
+  }
+
+  additional_litellm_params = {
+    max_retries    = 5
+    stream_timeout = 60
+  }
+}
+
 # Example: Azure model with parameter dropping
 resource "litellm_model" "azure_with_drop_params" {
   model_name          = "gpt-5-mini-coder"
@@ -49,7 +66,7 @@ resource "litellm_model" "azure_with_drop_params" {
 
   # Drop the reasoningEffort parameter that might be automatically added
   additional_litellm_params = {
-    "additional_drop_params" = "[\"reasoningEffort\"]"
+    additional_drop_params = ["reasoningEffort"]
   }
 
   input_cost_per_million_tokens  = 0.25
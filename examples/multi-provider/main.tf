@@ -153,10 +153,13 @@ resource "litellm_model" "bedrock_claude" {
   custom_llm_provider     = "bedrock"
   base_model              = "anthropic.claude-3-sonnet-20240229-v1:0"
   litellm_credential_name = litellm_credential.bedrock.credential_name
-  aws_region_name         = var.aws_region
   tier                    = "paid"
   mode                    = "chat"
 
+  bedrock {
+    aws_region_name = var.aws_region
+  }
+
   input_cost_per_million_tokens  = 3.0
   output_cost_per_million_tokens = 15.0
 }
@@ -166,10 +169,13 @@ resource "litellm_model" "bedrock_titan" {
   custom_llm_provider     = "bedrock"
   base_model              = "amazon.titan-embed-text-v1"
   litellm_credential_name = litellm_credential.bedrock.credential_name
-  aws_region_name         = var.aws_region
   tier                    = "paid"
   mode                    = "embedding"
 
+  bedrock {
+    aws_region_name = var.aws_region
+  }
+
   input_cost_per_million_tokens = 0.1
 }
 
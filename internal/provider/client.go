@@ -6,33 +6,36 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"reflect"
+	"strings"
+	"time"
 )
 
 // DoRequest performs an HTTP request with context and standard headers.
 func (c *Client) DoRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
-	url := c.APIBase + path
-
-	var req *http.Request
-	var err error
-
+	var reqBody io.Reader
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		req, err = http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(jsonBody))
-		if err != nil {
-			return nil, fmt.Errorf("failed to create request: %w", err)
-		}
-	} else {
-		req, err = http.NewRequestWithContext(ctx, method, url, nil)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create request: %w", err)
-		}
+		reqBody = bytes.NewBuffer(jsonBody)
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	return c.send(ctx, method, path, "application/json", reqBody)
+}
+
+// send performs an HTTP request with the authentication and additional
+// headers of the provider.
+func (c *Client) send(ctx context.Context, method, path, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.APIBase+path, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", contentType)
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("x-api-key", c.APIKey)
 
@@ -44,7 +47,16 @@ func (c *Client) DoRequest(ctx context.Context, method, path string, body interf
 		req.Header.Set("litellm-changed-by", c.LiteLLMChangedBy)
 	}
 
-	return c.HTTPClient.Do(req)
+	// A context deadline past the client timeout, as set by slow operations
+	// like health checks, takes precedence over it.
+	httpClient := c.HTTPClient
+	if deadline, ok := ctx.Deadline(); ok && httpClient.Timeout > 0 && time.Until(deadline) > httpClient.Timeout {
+		extended := *httpClient
+		extended.Timeout = 0
+		httpClient = &extended
+	}
+
+	return httpClient.Do(req)
 }
 
 // DoRequestWithResponse performs an HTTP request and decodes the JSON response.
@@ -82,6 +94,78 @@ func (c *Client) DoRequestWithResponse(ctx context.Context, method, path string,
 	return nil
 }
 
+// DoRequestWithStatus performs an HTTP request and returns its status code,
+// decoding the JSON response into result whatever the status. Only transport
+// errors are returned, so callers can inspect failed responses themselves.
+func (c *Client) DoRequestWithStatus(ctx context.Context, method, path string, body interface{}, result interface{}) (int, error) {
+	resp, err := c.DoRequest(ctx, method, path, body)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if result != nil && len(bodyBytes) > 0 {
+		// Error pages are not always JSON; leave result untouched then
+		_ = json.Unmarshal(bodyBytes, result)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// DoMultipartRequest uploads a file as multipart/form-data along with the given
+// form fields and decodes the JSON response.
+func (c *Client) DoMultipartRequest(ctx context.Context, path string, fields map[string]string, fileName string, content []byte, result interface{}) error {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	for key, value := range fields {
+		if err := writer.WriteField(key, value); err != nil {
+			return fmt.Errorf("failed to write form field %s: %w", key, err)
+		}
+	}
+
+	part, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return fmt.Errorf("failed to create form file: %w", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		return fmt.Errorf("failed to write file content: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to close multipart writer: %w", err)
+	}
+
+	resp, err := c.send(ctx, "POST", path, writer.FormDataContentType(), &buf)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(bodyBytes, result); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return nil
+}
+
 // IsNotFoundError checks if the error message indicates a not found condition.
 func IsNotFoundError(err error) bool {
 	if err == nil {
@@ -105,3 +189,154 @@ func containsImpl(s, substr string) bool {
 	}
 	return false
 }
+
+// VerifyConnection calls one of the proxy's connection test endpoints and
+// returns an error when the proxy reports that the connection failed. The test
+// endpoints answer with HTTP 200 even on failure, so the status in the body is
+// inspected as well.
+func (c *Client) VerifyConnection(ctx context.Context, path string, body interface{}) error {
+	var result map[string]interface{}
+	if err := c.DoRequestWithResponse(ctx, "POST", path, body, &result); err != nil {
+		return err
+	}
+
+	status, _ := result["status"].(string)
+	switch status {
+	case "error", "failed", "failure", "unhealthy":
+	default:
+		return nil
+	}
+
+	for _, key := range []string{"message", "error", "error_message"} {
+		if msg, ok := result[key].(string); ok && msg != "" {
+			return fmt.Errorf("connection test failed: %s", msg)
+		}
+	}
+	if nested, ok := result["result"].(map[string]interface{}); ok {
+		if msg, ok := nested["error"].(string); ok && msg != "" {
+			return fmt.Errorf("connection test failed: %s", msg)
+		}
+	}
+
+	return fmt.Errorf("connection test failed with status %q", status)
+}
+
+// ListOptions controls how ListAll walks a list endpoint.
+type ListOptions struct {
+	// ItemsKeys are the response fields that may hold the items, tried in
+	// order. Endpoints that return a bare JSON array need none.
+	ItemsKeys []string
+	// PageParam and SizeParam name the pagination query parameters. Endpoints
+	// without pagination leave PageParam empty and are fetched once.
+	PageParam string
+	SizeParam string
+	PageSize  int
+	// MaxItems stops paging once this many items were collected. Zero means
+	// no limit.
+	MaxItems int
+	// Filter, when set, drops items it returns false for before they count
+	// towards MaxItems.
+	Filter func(item interface{}) bool
+}
+
+// defaultListPageSize is the largest page size the proxy's list endpoints accept.
+const defaultListPageSize = 100
+
+// ListAll fetches every item from a list endpoint, following pages until the
+// total_pages or total_count reported by the proxy is reached, a short page is
+// returned, or opts.MaxItems items were collected.
+func (c *Client) ListAll(ctx context.Context, path string, opts ListOptions) ([]interface{}, error) {
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultListPageSize
+	}
+
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+
+	var all []interface{}
+	var previousFirst interface{}
+	fetched := 0
+
+	for page := 1; ; page++ {
+		endpoint := path
+		if opts.PageParam != "" {
+			endpoint = fmt.Sprintf("%s%s%s=%d", path, separator, opts.PageParam, page)
+			if opts.SizeParam != "" {
+				endpoint = fmt.Sprintf("%s&%s=%d", endpoint, opts.SizeParam, pageSize)
+			}
+		}
+
+		var result interface{}
+		if err := c.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
+			return nil, err
+		}
+
+		var items []interface{}
+		totalPages, totalCount := 0, 0
+
+		switch r := result.(type) {
+		case []interface{}:
+			items = r
+		case map[string]interface{}:
+			for _, key := range opts.ItemsKeys {
+				if arr, ok := r[key].([]interface{}); ok {
+					items = arr
+					break
+				}
+			}
+			if tp, ok := r["total_pages"].(float64); ok {
+				totalPages = int(tp)
+			} else if metadata, ok := r["metadata"].(map[string]interface{}); ok {
+				// The daily activity endpoints report paging inside metadata.
+				if tp, ok := metadata["total_pages"].(float64); ok {
+					totalPages = int(tp)
+				}
+			}
+			if tc, ok := r["total_count"].(float64); ok {
+				totalCount = int(tc)
+			} else if tc, ok := r["total"].(float64); ok {
+				totalCount = int(tc)
+			}
+		}
+
+		// Guard against proxies that ignore the page parameter and keep
+		// returning the first page.
+		if len(items) > 0 && page > 1 && reflect.DeepEqual(items[0], previousFirst) {
+			break
+		}
+		if len(items) > 0 {
+			previousFirst = items[0]
+		}
+
+		fetched += len(items)
+		for _, item := range items {
+			if opts.Filter == nil || opts.Filter(item) {
+				all = append(all, item)
+			}
+		}
+
+		if opts.MaxItems > 0 && len(all) >= opts.MaxItems {
+			return all[:opts.MaxItems], nil
+		}
+
+		more := false
+		if opts.PageParam != "" && len(items) > 0 {
+			switch {
+			case totalPages > 0:
+				more = page < totalPages
+			case totalCount > 0:
+				more = fetched < totalCount
+			default:
+				more = len(items) >= pageSize
+			}
+		}
+		if !more {
+			break
+		}
+	}
+
+	return all, nil
+}
@@ -0,0 +1,364 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &BudgetUtilizationDataSource{}
+
+func NewBudgetUtilizationDataSource() datasource.DataSource {
+	return &BudgetUtilizationDataSource{}
+}
+
+type BudgetUtilizationDataSource struct {
+	client *Client
+}
+
+type BudgetUtilizationDataSourceModel struct {
+	ID               types.String                   `tfsdk:"id"`
+	EntityTypes      types.List                     `tfsdk:"entity_types"`
+	Budget           types.String                   `tfsdk:"budget"`
+	WarningThreshold types.Float64                  `tfsdk:"warning_threshold"`
+	ErrorThreshold   types.Float64                  `tfsdk:"error_threshold"`
+	WarningCount     types.Int64                    `tfsdk:"warning_count"`
+	ErrorCount       types.Int64                    `tfsdk:"error_count"`
+	Entities         []BudgetUtilizationEntityModel `tfsdk:"entities"`
+}
+
+type BudgetUtilizationEntityModel struct {
+	Type               types.String  `tfsdk:"type"`
+	ID                 types.String  `tfsdk:"id"`
+	Name               types.String  `tfsdk:"name"`
+	Spend              types.Float64 `tfsdk:"spend"`
+	MaxBudget          types.Float64 `tfsdk:"max_budget"`
+	SoftBudget         types.Float64 `tfsdk:"soft_budget"`
+	UtilizationPercent types.Float64 `tfsdk:"utilization_percent"`
+	Status             types.String  `tfsdk:"status"`
+}
+
+// budgetEntity describes where one kind of entity is listed and which fields
+// identify it.
+type budgetEntity struct {
+	endpoint  string
+	opts      ListOptions
+	idField   string
+	nameField []string
+}
+
+var budgetEntities = map[string]budgetEntity{
+	"key": {
+		endpoint:  "/key/list?return_full_object=true",
+		opts:      ListOptions{ItemsKeys: []string{"keys", "data"}, PageParam: "page", SizeParam: "size"},
+		idField:   "token",
+		nameField: []string{"key_alias", "key_name"},
+	},
+	"team": {
+		endpoint:  "/v2/team/list",
+		opts:      ListOptions{ItemsKeys: []string{"teams", "data"}, PageParam: "page", SizeParam: "page_size"},
+		idField:   "team_id",
+		nameField: []string{"team_alias"},
+	},
+	"organization": {
+		endpoint:  "/organization/list",
+		opts:      ListOptions{ItemsKeys: []string{"organizations", "data"}},
+		idField:   "organization_id",
+		nameField: []string{"organization_alias"},
+	},
+	"user": {
+		endpoint:  "/user/list",
+		opts:      ListOptions{ItemsKeys: []string{"users", "data"}, PageParam: "page", SizeParam: "page_size"},
+		idField:   "user_id",
+		nameField: []string{"user_alias", "user_email"},
+	},
+	"tag": {
+		endpoint:  "/tag/list",
+		opts:      ListOptions{ItemsKeys: []string{"tags", "data"}},
+		idField:   "name",
+		nameField: []string{"name"},
+	},
+}
+
+// budgetEntityOrder is the order entity types are checked and reported in.
+var budgetEntityOrder = []string{"key", "team", "organization", "user", "tag"}
+
+// defaultBudgetEntityTypes leaves out users, which are usually far more
+// numerous and rarely carry their own budget.
+var defaultBudgetEntityTypes = []string{"key", "team", "organization", "tag"}
+
+const defaultBudgetWarningThreshold = 80.0
+
+func (d *BudgetUtilizationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_budget_utilization"
+}
+
+func (d *BudgetUtilizationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Compares the live spend of keys, teams, organizations, users and tags with their budgets. Raises a warning for entities above warning_threshold and fails the run for entities above error_threshold.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier.",
+				Computed:    true,
+			},
+			"entity_types": schema.ListAttribute{
+				Description: "Entity types to check. Valid values: key, team, organization, user, tag. Defaults to key, team, organization and tag.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(budgetEntityOrder...)),
+				},
+			},
+			"budget": schema.StringAttribute{
+				Description: "Budget that utilization is measured against. Valid values: max_budget, soft_budget. Defaults to max_budget.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("max_budget", "soft_budget"),
+				},
+			},
+			"warning_threshold": schema.Float64Attribute{
+				Description: "Utilization percentage at or above which a warning is raised. Defaults to 80.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"error_threshold": schema.Float64Attribute{
+				Description: "Utilization percentage at or above which the data source fails with an error. No error is raised when unset.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"warning_count": schema.Int64Attribute{
+				Description: "Number of entities at or above warning_threshold but below error_threshold.",
+				Computed:    true,
+			},
+			"error_count": schema.Int64Attribute{
+				Description: "Number of entities at or above error_threshold.",
+				Computed:    true,
+			},
+			"entities": schema.ListNestedAttribute{
+				Description: "Every checked entity that has a budget, highest utilization first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Entity type: key, team, organization, user or tag.",
+							Computed:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "Entity ID. For keys this is the hashed token.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Alias or name of the entity.",
+							Computed:    true,
+						},
+						"spend": schema.Float64Attribute{
+							Description: "Current spend in USD.",
+							Computed:    true,
+						},
+						"max_budget": schema.Float64Attribute{
+							Description: "Maximum budget in USD.",
+							Computed:    true,
+						},
+						"soft_budget": schema.Float64Attribute{
+							Description: "Soft budget in USD.",
+							Computed:    true,
+						},
+						"utilization_percent": schema.Float64Attribute{
+							Description: "Spend as a percentage of the selected budget.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "ok, warning or error depending on the thresholds.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BudgetUtilizationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BudgetUtilizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BudgetUtilizationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entityTypes := defaultBudgetEntityTypes
+	if !data.EntityTypes.IsNull() {
+		entityTypes = nil
+		data.EntityTypes.ElementsAs(ctx, &entityTypes, false)
+	}
+	selected := map[string]bool{}
+	for _, t := range entityTypes {
+		selected[t] = true
+	}
+
+	budgetField := "max_budget"
+	if !data.Budget.IsNull() && data.Budget.ValueString() != "" {
+		budgetField = data.Budget.ValueString()
+	}
+
+	warningThreshold := defaultBudgetWarningThreshold
+	if !data.WarningThreshold.IsNull() {
+		warningThreshold = data.WarningThreshold.ValueFloat64()
+	}
+
+	data.ID = types.StringValue("budget_utilization")
+	data.Entities = []BudgetUtilizationEntityModel{}
+
+	for _, entityType := range budgetEntityOrder {
+		if !selected[entityType] {
+			continue
+		}
+		entity := budgetEntities[entityType]
+
+		items, err := d.client.ListAll(ctx, entity.endpoint, entity.opts)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list %ss: %s", entityType, err))
+			return
+		}
+
+		for _, i := range items {
+			item, ok := i.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			maxBudget, hasMax := budgetValue(item, "max_budget")
+			softBudget, hasSoft := budgetValue(item, "soft_budget")
+			budget, hasBudget := maxBudget, hasMax
+			if budgetField == "soft_budget" {
+				budget, hasBudget = softBudget, hasSoft
+			}
+			if !hasBudget || budget <= 0 {
+				continue
+			}
+
+			spend, _ := item["spend"].(float64)
+			utilization := spend / budget * 100
+
+			row := BudgetUtilizationEntityModel{
+				Type:               types.StringValue(entityType),
+				ID:                 types.StringNull(),
+				Name:               types.StringNull(),
+				Spend:              types.Float64Value(spend),
+				MaxBudget:          types.Float64Null(),
+				SoftBudget:         types.Float64Null(),
+				UtilizationPercent: types.Float64Value(utilization),
+				Status:             types.StringValue("ok"),
+			}
+			if id, ok := item[entity.idField].(string); ok {
+				row.ID = types.StringValue(id)
+			}
+			for _, field := range entity.nameField {
+				if name, ok := item[field].(string); ok && name != "" {
+					row.Name = types.StringValue(name)
+					break
+				}
+			}
+			if hasMax {
+				row.MaxBudget = types.Float64Value(maxBudget)
+			}
+			if hasSoft {
+				row.SoftBudget = types.Float64Value(softBudget)
+			}
+
+			switch {
+			case !data.ErrorThreshold.IsNull() && utilization >= data.ErrorThreshold.ValueFloat64():
+				row.Status = types.StringValue("error")
+			case utilization >= warningThreshold:
+				row.Status = types.StringValue("warning")
+			}
+
+			data.Entities = append(data.Entities, row)
+		}
+	}
+
+	sort.SliceStable(data.Entities, func(i, j int) bool {
+		return data.Entities[i].UtilizationPercent.ValueFloat64() > data.Entities[j].UtilizationPercent.ValueFloat64()
+	})
+
+	var warnings, failures []string
+	for _, e := range data.Entities {
+		line := fmt.Sprintf("%s %s: %.1f%% of %s (spend %.2f)", e.Type.ValueString(), budgetEntityLabel(e), e.UtilizationPercent.ValueFloat64(), budgetField, e.Spend.ValueFloat64())
+		switch e.Status.ValueString() {
+		case "error":
+			failures = append(failures, line)
+		case "warning":
+			warnings = append(warnings, line)
+		}
+	}
+
+	data.WarningCount = types.Int64Value(int64(len(warnings)))
+	data.ErrorCount = types.Int64Value(int64(len(failures)))
+
+	if len(warnings) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Budget Utilization Warning",
+			fmt.Sprintf("%d entities are at or above %.1f%% of their %s:\n%s", len(warnings), warningThreshold, budgetField, strings.Join(warnings, "\n")),
+		)
+	}
+	if len(failures) > 0 {
+		resp.Diagnostics.AddError(
+			"Budget Utilization Exceeded",
+			fmt.Sprintf("%d entities are at or above %.1f%% of their %s:\n%s", len(failures), data.ErrorThreshold.ValueFloat64(), budgetField, strings.Join(failures, "\n")),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// budgetValue reads a budget from a list item, falling back to the linked
+// budget the proxy nests under litellm_budget_table.
+func budgetValue(item map[string]interface{}, field string) (float64, bool) {
+	if v, ok := item[field].(float64); ok {
+		return v, true
+	}
+	if v, ok := lookupField(item, "litellm_budget_table."+field).(float64); ok {
+		return v, true
+	}
+	return 0, false
+}
+
+// budgetEntityLabel names an entity in diagnostics by its alias, falling back
+// to its ID.
+func budgetEntityLabel(e BudgetUtilizationEntityModel) string {
+	if !e.Name.IsNull() {
+		return fmt.Sprintf("%q", e.Name.ValueString())
+	}
+	return e.ID.ValueString()
+}
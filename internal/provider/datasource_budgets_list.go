@@ -21,8 +21,9 @@ type BudgetsListDataSource struct {
 }
 
 type BudgetsListDataSourceModel struct {
-	ID      types.String          `tfsdk:"id"`
-	Budgets []BudgetListItemModel `tfsdk:"budgets"`
+	ID       types.String          `tfsdk:"id"`
+	MaxItems types.Int64           `tfsdk:"max_items"`
+	Budgets  []BudgetListItemModel `tfsdk:"budgets"`
 }
 
 type BudgetListItemModel struct {
@@ -48,6 +49,10 @@ func (d *BudgetsListDataSource) Schema(ctx context.Context, req datasource.Schem
 				Description: "Placeholder identifier for this data source.",
 				Computed:    true,
 			},
+			"max_items": schema.Int64Attribute{
+				Description: "Maximum number of budgets to return. All budgets are returned when unset.",
+				Optional:    true,
+			},
 			"budgets": schema.ListNestedAttribute{
 				Description: "List of budgets.",
 				Computed:    true,
@@ -117,14 +122,21 @@ func (d *BudgetsListDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	var results []map[string]interface{}
-	if err := d.client.DoRequestWithResponse(ctx, "GET", "/budget/list", nil, &results); err != nil {
+	results, err := d.client.ListAll(ctx, "/budget/list", ListOptions{
+		ItemsKeys: []string{"budgets", "data"},
+		MaxItems:  int(data.MaxItems.ValueInt64()),
+	})
+	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list budgets: %s", err))
 		return
 	}
 
 	budgets := make([]BudgetListItemModel, 0, len(results))
-	for _, result := range results {
+	for _, r := range results {
+		result, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
 		budget := BudgetListItemModel{}
 
 		if budgetID, ok := result["budget_id"].(string); ok {
@@ -0,0 +1,494 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DailyActivityDataSource{}
+
+// dailyActivityKind describes one of the proxy's daily activity endpoints.
+type dailyActivityKind struct {
+	typeName    string
+	endpoint    string
+	description string
+	// idsParam is the query parameter selecting the entities to report on,
+	// exposed as the ids attribute. Endpoints without one have no ids.
+	idsParam       string
+	idsDescription string
+	paginated      bool
+}
+
+func NewTeamDailyActivityDataSource() datasource.DataSource {
+	return &DailyActivityDataSource{kind: dailyActivityKind{
+		typeName:       "_team_daily_activity",
+		endpoint:       "/team/daily/activity",
+		description:    "Retrieves daily spend, token and request counts for teams.",
+		idsParam:       "team_ids",
+		idsDescription: "Team IDs to report on. Defaults to every team the caller can see.",
+		paginated:      true,
+	}}
+}
+
+func NewUserDailyActivityDataSource() datasource.DataSource {
+	return &DailyActivityDataSource{kind: dailyActivityKind{
+		typeName:    "_user_daily_activity",
+		endpoint:    "/user/daily/activity/aggregated",
+		description: "Retrieves daily spend, token and request counts for the calling user, or for every user when called with an admin key.",
+	}}
+}
+
+func NewOrganizationDailyActivityDataSource() datasource.DataSource {
+	return &DailyActivityDataSource{kind: dailyActivityKind{
+		typeName:       "_organization_daily_activity",
+		endpoint:       "/organization/daily/activity",
+		description:    "Retrieves daily spend, token and request counts for organizations.",
+		idsParam:       "organization_ids",
+		idsDescription: "Organization IDs to report on. Defaults to every organization the caller can see.",
+		paginated:      true,
+	}}
+}
+
+func NewTagDailyActivityDataSource() datasource.DataSource {
+	return &DailyActivityDataSource{kind: dailyActivityKind{
+		typeName:       "_tag_daily_activity",
+		endpoint:       "/tag/daily/activity",
+		description:    "Retrieves daily spend, token and request counts for request tags.",
+		idsParam:       "tags",
+		idsDescription: "Tags to report on. Defaults to every tag.",
+		paginated:      true,
+	}}
+}
+
+func NewCustomerDailyActivityDataSource() datasource.DataSource {
+	return &DailyActivityDataSource{kind: dailyActivityKind{
+		typeName:       "_customer_daily_activity",
+		endpoint:       "/customer/daily/activity",
+		description:    "Retrieves daily spend, token and request counts for customers (end users).",
+		idsParam:       "end_user_ids",
+		idsDescription: "End user IDs to report on. Defaults to every customer.",
+		paginated:      true,
+	}}
+}
+
+// DailyActivityDataSource backs the team, user, organization, tag and customer
+// daily activity data sources, which share one response format.
+type DailyActivityDataSource struct {
+	client *Client
+	kind   dailyActivityKind
+}
+
+type DailyActivityDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	StartDate types.String `tfsdk:"start_date"`
+	EndDate   types.String `tfsdk:"end_date"`
+	Model     types.String `tfsdk:"model"`
+	APIKey    types.String `tfsdk:"api_key"`
+	GroupBy   types.String `tfsdk:"group_by"`
+
+	TotalSpend              types.Float64 `tfsdk:"total_spend"`
+	TotalPromptTokens       types.Int64   `tfsdk:"total_prompt_tokens"`
+	TotalCompletionTokens   types.Int64   `tfsdk:"total_completion_tokens"`
+	TotalTokens             types.Int64   `tfsdk:"total_tokens"`
+	TotalAPIRequests        types.Int64   `tfsdk:"total_api_requests"`
+	TotalSuccessfulRequests types.Int64   `tfsdk:"total_successful_requests"`
+	TotalFailedRequests     types.Int64   `tfsdk:"total_failed_requests"`
+
+	Days      []DailyActivityDayModel   `tfsdk:"days"`
+	Breakdown []DailyActivityGroupModel `tfsdk:"breakdown"`
+}
+
+// dailyActivityWithIDsModel is the model of the data sources that take ids.
+type dailyActivityWithIDsModel struct {
+	IDs types.List `tfsdk:"ids"`
+	DailyActivityDataSourceModel
+}
+
+// SpendMetricsModel holds the spend, token and request counts the proxy
+// reports for a day or for one group within a day.
+type SpendMetricsModel struct {
+	Spend                    types.Float64 `tfsdk:"spend"`
+	PromptTokens             types.Int64   `tfsdk:"prompt_tokens"`
+	CompletionTokens         types.Int64   `tfsdk:"completion_tokens"`
+	CacheReadInputTokens     types.Int64   `tfsdk:"cache_read_input_tokens"`
+	CacheCreationInputTokens types.Int64   `tfsdk:"cache_creation_input_tokens"`
+	TotalTokens              types.Int64   `tfsdk:"total_tokens"`
+	APIRequests              types.Int64   `tfsdk:"api_requests"`
+	SuccessfulRequests       types.Int64   `tfsdk:"successful_requests"`
+	FailedRequests           types.Int64   `tfsdk:"failed_requests"`
+}
+
+type DailyActivityDayModel struct {
+	Date types.String `tfsdk:"date"`
+	SpendMetricsModel
+}
+
+type DailyActivityGroupModel struct {
+	Date  types.String `tfsdk:"date"`
+	Group types.String `tfsdk:"group"`
+	SpendMetricsModel
+}
+
+// dailyActivityGroupings are the breakdowns the proxy reports per day.
+var dailyActivityGroupings = []string{
+	"entities",
+	"models",
+	"model_groups",
+	"providers",
+	"api_keys",
+	"endpoints",
+	"mcp_servers",
+}
+
+var isoDateRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+func isoDateValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(isoDateRegex, "must be a date in YYYY-MM-DD format"),
+	}
+}
+
+func spendMetricsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"spend": schema.Float64Attribute{
+			Description: "Spend in USD.",
+			Computed:    true,
+		},
+		"prompt_tokens": schema.Int64Attribute{
+			Description: "Prompt tokens.",
+			Computed:    true,
+		},
+		"completion_tokens": schema.Int64Attribute{
+			Description: "Completion tokens.",
+			Computed:    true,
+		},
+		"cache_read_input_tokens": schema.Int64Attribute{
+			Description: "Input tokens read from the provider's prompt cache.",
+			Computed:    true,
+		},
+		"cache_creation_input_tokens": schema.Int64Attribute{
+			Description: "Input tokens written to the provider's prompt cache.",
+			Computed:    true,
+		},
+		"total_tokens": schema.Int64Attribute{
+			Description: "Total tokens.",
+			Computed:    true,
+		},
+		"api_requests": schema.Int64Attribute{
+			Description: "Number of requests.",
+			Computed:    true,
+		},
+		"successful_requests": schema.Int64Attribute{
+			Description: "Number of successful requests.",
+			Computed:    true,
+		},
+		"failed_requests": schema.Int64Attribute{
+			Description: "Number of failed requests.",
+			Computed:    true,
+		},
+	}
+}
+
+func (d *DailyActivityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + d.kind.typeName
+}
+
+func (d *DailyActivityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	dayAttributes := spendMetricsAttributes()
+	dayAttributes["date"] = schema.StringAttribute{
+		Description: "Day in YYYY-MM-DD format.",
+		Computed:    true,
+	}
+
+	groupAttributes := spendMetricsAttributes()
+	groupAttributes["date"] = schema.StringAttribute{
+		Description: "Day in YYYY-MM-DD format.",
+		Computed:    true,
+	}
+	groupAttributes["group"] = schema.StringAttribute{
+		Description: "The model, provider, key or other group the metrics belong to.",
+		Computed:    true,
+	}
+
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Placeholder identifier.",
+			Computed:    true,
+		},
+		"start_date": schema.StringAttribute{
+			Description: "First day to report on, in YYYY-MM-DD format.",
+			Required:    true,
+			Validators:  isoDateValidators(),
+		},
+		"end_date": schema.StringAttribute{
+			Description: "Last day to report on, in YYYY-MM-DD format.",
+			Required:    true,
+			Validators:  isoDateValidators(),
+		},
+		"model": schema.StringAttribute{
+			Description: "Only count requests to this model.",
+			Optional:    true,
+		},
+		"api_key": schema.StringAttribute{
+			Description: "Only count requests made with this key (hashed token).",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"group_by": schema.StringAttribute{
+			Description: "Populate breakdown with per-day metrics grouped by one of: " + strings.Join(dailyActivityGroupings, ", ") + ".",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(dailyActivityGroupings...),
+			},
+		},
+		"total_spend": schema.Float64Attribute{
+			Description: "Total spend in USD over the date range.",
+			Computed:    true,
+		},
+		"total_prompt_tokens": schema.Int64Attribute{
+			Description: "Total prompt tokens over the date range.",
+			Computed:    true,
+		},
+		"total_completion_tokens": schema.Int64Attribute{
+			Description: "Total completion tokens over the date range.",
+			Computed:    true,
+		},
+		"total_tokens": schema.Int64Attribute{
+			Description: "Total tokens over the date range.",
+			Computed:    true,
+		},
+		"total_api_requests": schema.Int64Attribute{
+			Description: "Total requests over the date range.",
+			Computed:    true,
+		},
+		"total_successful_requests": schema.Int64Attribute{
+			Description: "Total successful requests over the date range.",
+			Computed:    true,
+		},
+		"total_failed_requests": schema.Int64Attribute{
+			Description: "Total failed requests over the date range.",
+			Computed:    true,
+		},
+		"days": schema.ListNestedAttribute{
+			Description: "Metrics for each day, oldest first.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: dayAttributes,
+			},
+		},
+		"breakdown": schema.ListNestedAttribute{
+			Description: "Metrics for each day and group_by group, ordered by date and group. Empty unless group_by is set.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: groupAttributes,
+			},
+		},
+	}
+
+	if d.kind.idsParam != "" {
+		attributes["ids"] = schema.ListAttribute{
+			Description: d.kind.idsDescription,
+			Optional:    true,
+			ElementType: types.StringType,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: d.kind.description,
+		Attributes:  attributes,
+	}
+}
+
+func (d *DailyActivityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DailyActivityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DailyActivityDataSourceModel
+	ids := types.ListNull(types.StringType)
+
+	if d.kind.idsParam != "" {
+		var config dailyActivityWithIDsModel
+		resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+		data, ids = config.DailyActivityDataSourceModel, config.IDs
+	} else {
+		resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := url.Values{}
+	params.Set("start_date", data.StartDate.ValueString())
+	params.Set("end_date", data.EndDate.ValueString())
+	if !data.Model.IsNull() && data.Model.ValueString() != "" {
+		params.Set("model", data.Model.ValueString())
+	}
+	if !data.APIKey.IsNull() && data.APIKey.ValueString() != "" {
+		params.Set("api_key", data.APIKey.ValueString())
+	}
+	if !ids.IsNull() {
+		var values []string
+		ids.ElementsAs(ctx, &values, false)
+		if len(values) > 0 {
+			params.Set(d.kind.idsParam, strings.Join(values, ","))
+		}
+	}
+
+	opts := ListOptions{ItemsKeys: []string{"results"}}
+	if d.kind.paginated {
+		opts.PageParam = "page"
+		opts.SizeParam = "page_size"
+	}
+
+	results, err := d.client.ListAll(ctx, d.kind.endpoint+"?"+params.Encode(), opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read daily activity: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(strings.TrimPrefix(d.kind.typeName, "_"))
+
+	// Pages may split one day's results, so days are merged by date and the
+	// totals are summed here rather than taken from the page metadata.
+	days := map[string]*SpendMetricsModel{}
+	groups := map[string]map[string]*SpendMetricsModel{}
+	total := newSpendMetrics()
+
+	for _, r := range results {
+		result, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		date, _ := result["date"].(string)
+		metrics, _ := result["metrics"].(map[string]interface{})
+
+		if days[date] == nil {
+			days[date] = newSpendMetrics()
+		}
+		addSpendMetrics(days[date], metrics)
+		addSpendMetrics(total, metrics)
+
+		if data.GroupBy.IsNull() {
+			continue
+		}
+		breakdown, _ := result["breakdown"].(map[string]interface{})
+		grouped, _ := breakdown[data.GroupBy.ValueString()].(map[string]interface{})
+		for group, g := range grouped {
+			entry, ok := g.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if groups[date] == nil {
+				groups[date] = map[string]*SpendMetricsModel{}
+			}
+			if groups[date][group] == nil {
+				groups[date][group] = newSpendMetrics()
+			}
+			groupMetrics, _ := entry["metrics"].(map[string]interface{})
+			addSpendMetrics(groups[date][group], groupMetrics)
+		}
+	}
+
+	data.TotalSpend = total.Spend
+	data.TotalPromptTokens = total.PromptTokens
+	data.TotalCompletionTokens = total.CompletionTokens
+	data.TotalTokens = total.TotalTokens
+	data.TotalAPIRequests = total.APIRequests
+	data.TotalSuccessfulRequests = total.SuccessfulRequests
+	data.TotalFailedRequests = total.FailedRequests
+
+	dates := make([]string, 0, len(days))
+	for date := range days {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+
+	data.Days = make([]DailyActivityDayModel, 0, len(dates))
+	data.Breakdown = []DailyActivityGroupModel{}
+	for _, date := range dates {
+		data.Days = append(data.Days, DailyActivityDayModel{
+			Date:              types.StringValue(date),
+			SpendMetricsModel: *days[date],
+		})
+
+		names := make([]string, 0, len(groups[date]))
+		for name := range groups[date] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			data.Breakdown = append(data.Breakdown, DailyActivityGroupModel{
+				Date:              types.StringValue(date),
+				Group:             types.StringValue(name),
+				SpendMetricsModel: *groups[date][name],
+			})
+		}
+	}
+
+	if d.kind.idsParam != "" {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &dailyActivityWithIDsModel{IDs: ids, DailyActivityDataSourceModel: data})...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func newSpendMetrics() *SpendMetricsModel {
+	return &SpendMetricsModel{
+		Spend:                    types.Float64Value(0),
+		PromptTokens:             types.Int64Value(0),
+		CompletionTokens:         types.Int64Value(0),
+		CacheReadInputTokens:     types.Int64Value(0),
+		CacheCreationInputTokens: types.Int64Value(0),
+		TotalTokens:              types.Int64Value(0),
+		APIRequests:              types.Int64Value(0),
+		SuccessfulRequests:       types.Int64Value(0),
+		FailedRequests:           types.Int64Value(0),
+	}
+}
+
+// addSpendMetrics adds a metrics object from the API onto m.
+func addSpendMetrics(m *SpendMetricsModel, metrics map[string]interface{}) {
+	if spend, ok := metrics["spend"].(float64); ok {
+		m.Spend = types.Float64Value(m.Spend.ValueFloat64() + spend)
+	}
+
+	counters := map[string]*types.Int64{
+		"prompt_tokens":               &m.PromptTokens,
+		"completion_tokens":           &m.CompletionTokens,
+		"cache_read_input_tokens":     &m.CacheReadInputTokens,
+		"cache_creation_input_tokens": &m.CacheCreationInputTokens,
+		"total_tokens":                &m.TotalTokens,
+		"api_requests":                &m.APIRequests,
+		"successful_requests":         &m.SuccessfulRequests,
+		"failed_requests":             &m.FailedRequests,
+	}
+	for key, counter := range counters {
+		if v, ok := metrics[key].(float64); ok {
+			*counter = types.Int64Value(counter.ValueInt64() + int64(v))
+		}
+	}
+}
@@ -22,6 +22,7 @@ type GuardrailsListDataSource struct {
 
 type GuardrailsListDataSourceModel struct {
 	ID         types.String             `tfsdk:"id"`
+	MaxItems   types.Int64              `tfsdk:"max_items"`
 	Guardrails []GuardrailListItemModel `tfsdk:"guardrails"`
 }
 
@@ -48,6 +49,10 @@ func (d *GuardrailsListDataSource) Schema(ctx context.Context, req datasource.Sc
 				Description: "Placeholder identifier for this data source.",
 				Computed:    true,
 			},
+			"max_items": schema.Int64Attribute{
+				Description: "Maximum number of guardrails to return. All guardrails are returned when unset.",
+				Optional:    true,
+			},
 			"guardrails": schema.ListNestedAttribute{
 				Description: "List of guardrails.",
 				Computed:    true,
@@ -117,14 +122,21 @@ func (d *GuardrailsListDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	var results []map[string]interface{}
-	if err := d.client.DoRequestWithResponse(ctx, "GET", "/guardrails/list", nil, &results); err != nil {
+	results, err := d.client.ListAll(ctx, "/guardrails/list", ListOptions{
+		ItemsKeys: []string{"guardrails", "data"},
+		MaxItems:  int(data.MaxItems.ValueInt64()),
+	})
+	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list guardrails: %s", err))
 		return
 	}
 
 	guardrails := make([]GuardrailListItemModel, 0, len(results))
-	for _, result := range results {
+	for _, r := range results {
+		result, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
 		guardrail := GuardrailListItemModel{}
 
 		if guardrailID, ok := result["guardrail_id"].(string); ok {
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &HealthDataSource{}
+
+func NewHealthDataSource() datasource.DataSource {
+	return &HealthDataSource{}
+}
+
+type HealthDataSource struct {
+	client *Client
+}
+
+type HealthDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Live             types.Bool   `tfsdk:"live"`
+	Ready            types.Bool   `tfsdk:"ready"`
+	Status           types.String `tfsdk:"status"`
+	DBStatus         types.String `tfsdk:"db_status"`
+	CacheType        types.String `tfsdk:"cache_type"`
+	LiteLLMVersion   types.String `tfsdk:"litellm_version"`
+	SuccessCallbacks types.List   `tfsdk:"success_callbacks"`
+	ErrorMessage     types.String `tfsdk:"error_message"`
+}
+
+func (d *HealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_health"
+}
+
+func (d *HealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the liveness and readiness of the proxy, including database and cache status. An unhealthy proxy is reported through the attributes rather than as an error, so they can be asserted on in check blocks and postconditions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier.",
+				Computed:    true,
+			},
+			"live": schema.BoolAttribute{
+				Description: "Whether the proxy worker is alive.",
+				Computed:    true,
+			},
+			"ready": schema.BoolAttribute{
+				Description: "Whether the proxy is ready to receive requests, including a working database connection when one is configured.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Readiness status reported by the proxy, e.g. healthy or connected.",
+				Computed:    true,
+			},
+			"db_status": schema.StringAttribute{
+				Description: "Database status, e.g. connected or Not connected.",
+				Computed:    true,
+			},
+			"cache_type": schema.StringAttribute{
+				Description: "Type of cache the proxy uses, e.g. redis. Null when caching is disabled.",
+				Computed:    true,
+			},
+			"litellm_version": schema.StringAttribute{
+				Description: "LiteLLM version the proxy runs.",
+				Computed:    true,
+			},
+			"success_callbacks": schema.ListAttribute{
+				Description: "Success callbacks configured on the proxy.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"error_message": schema.StringAttribute{
+				Description: "Reason the proxy is not live or ready. Null when it is both.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *HealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *HealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HealthDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var liveness interface{}
+	liveCode, err := d.client.DoRequestWithStatus(ctx, "GET", "/health/liveliness", nil, &liveness)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read proxy liveness: %s", err))
+		return
+	}
+
+	var readiness map[string]interface{}
+	readyCode, err := d.client.DoRequestWithStatus(ctx, "GET", "/health/readiness", nil, &readiness)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read proxy readiness: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("health")
+	data.Live = types.BoolValue(isHealthyStatusCode(liveCode))
+	data.Ready = types.BoolValue(isHealthyStatusCode(readyCode))
+
+	stringFields := map[string]*types.String{
+		"status":          &data.Status,
+		"db":              &data.DBStatus,
+		"cache":           &data.CacheType,
+		"litellm_version": &data.LiteLLMVersion,
+	}
+	for key, target := range stringFields {
+		if v, ok := readiness[key].(string); ok && v != "" {
+			*target = types.StringValue(v)
+		} else {
+			*target = types.StringNull()
+		}
+	}
+
+	callbacks, _ := readiness["success_callbacks"].([]interface{})
+	data.SuccessCallbacks, _ = types.ListValueFrom(ctx, types.StringType, interfaceSliceToStrings(callbacks))
+
+	data.ErrorMessage = types.StringNull()
+	switch {
+	case !data.Live.ValueBool():
+		data.ErrorMessage = types.StringValue(healthErrorMessage(liveCode, liveness))
+	case !data.Ready.ValueBool():
+		data.ErrorMessage = types.StringValue(healthErrorMessage(readyCode, readiness))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func isHealthyStatusCode(code int) bool {
+	return code >= 200 && code < 300
+}
+
+// healthErrorMessage extracts the reason from a failed health response. The
+// proxy returns either {"detail": "..."} or {"error": {"message": "..."}}.
+func healthErrorMessage(code int, body interface{}) string {
+	if bodyMap, ok := body.(map[string]interface{}); ok {
+		if detail, ok := bodyMap["detail"].(string); ok && detail != "" {
+			return detail
+		}
+		if errMap, ok := bodyMap["error"].(map[string]interface{}); ok {
+			if message, ok := errMap["message"].(string); ok && message != "" {
+				return message
+			}
+		}
+		if message, ok := bodyMap["message"].(string); ok && message != "" {
+			return message
+		}
+	}
+	return fmt.Sprintf("status %d %s", code, http.StatusText(code))
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -32,10 +33,12 @@ type KeyListItem struct {
 }
 
 type KeysListDataSourceModel struct {
-	ID     types.String  `tfsdk:"id"`
-	TeamID types.String  `tfsdk:"team_id"`
-	UserID types.String  `tfsdk:"user_id"`
-	Keys   []KeyListItem `tfsdk:"keys"`
+	ID       types.String     `tfsdk:"id"`
+	TeamID   types.String     `tfsdk:"team_id"`
+	UserID   types.String     `tfsdk:"user_id"`
+	MaxItems types.Int64      `tfsdk:"max_items"`
+	Filter   *ListFilterModel `tfsdk:"filter"`
+	Keys     []KeyListItem    `tfsdk:"keys"`
 }
 
 func (d *KeysListDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -58,6 +61,10 @@ func (d *KeysListDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				Description: "Optional user ID to filter keys by user.",
 				Optional:    true,
 			},
+			"max_items": schema.Int64Attribute{
+				Description: "Maximum number of keys to return. All keys are returned when unset.",
+				Optional:    true,
+			},
 			"keys": schema.ListNestedAttribute{
 				Description: "List of keys.",
 				Computed:    true,
@@ -103,6 +110,9 @@ func (d *KeysListDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock(),
+		},
 	}
 }
 
@@ -131,29 +141,42 @@ func (d *KeysListDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	// Build endpoint with optional filters
-	endpoint := "/key/list"
-	params := []string{}
+	filter, diags := newListFilter(ctx, data.Filter, listFilterFields{
+		Name:      "key_name",
+		Alias:     "key_alias",
+		Metadata:  "metadata",
+		Blocked:   "blocked",
+		Spend:     "spend",
+		Budget:    "max_budget",
+		CreatedAt: "created_at",
+	}, "litellm_keys")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	// Build endpoint with optional filters. Full objects are requested so that
+	// each page carries key details rather than bare tokens.
+	endpoint := "/key/list?return_full_object=true"
 	if !data.TeamID.IsNull() && data.TeamID.ValueString() != "" {
-		params = append(params, fmt.Sprintf("team_id=%s", data.TeamID.ValueString()))
+		endpoint += "&team_id=" + url.QueryEscape(data.TeamID.ValueString())
 	}
 	if !data.UserID.IsNull() && data.UserID.ValueString() != "" {
-		params = append(params, fmt.Sprintf("user_id=%s", data.UserID.ValueString()))
+		endpoint += "&user_id=" + url.QueryEscape(data.UserID.ValueString())
 	}
-
-	if len(params) > 0 {
-		endpoint += "?"
-		for i, p := range params {
-			if i > 0 {
-				endpoint += "&"
-			}
-			endpoint += p
-		}
+	// key_alias is an exact match, so only anchored literal patterns are pushed down.
+	if alias, exact, ok := filter.aliasLiteral(); ok && exact {
+		endpoint += "&key_alias=" + url.QueryEscape(alias)
 	}
 
-	var result map[string]interface{}
-	if err := d.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
+	keysData, err := d.client.ListAll(ctx, endpoint, ListOptions{
+		ItemsKeys: []string{"keys", "data"},
+		PageParam: "page",
+		SizeParam: "size",
+		MaxItems:  int(data.MaxItems.ValueInt64()),
+		Filter:    filter.Match,
+	})
+	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list keys: %s", err))
 		return
 	}
@@ -161,14 +184,6 @@ func (d *KeysListDataSource) Read(ctx context.Context, req datasource.ReadReques
 	// Set placeholder ID
 	data.ID = types.StringValue("keys")
 
-	// Parse the response
-	var keysData []interface{}
-	if keys, ok := result["keys"].([]interface{}); ok {
-		keysData = keys
-	} else if dataArr, ok := result["data"].([]interface{}); ok {
-		keysData = dataArr
-	}
-
 	data.Keys = make([]KeyListItem, 0, len(keysData))
 	for _, k := range keysData {
 		keyMap, ok := k.(map[string]interface{})
@@ -36,6 +36,8 @@ type MCPServerListItem struct {
 
 type MCPServersListDataSourceModel struct {
 	ID         types.String        `tfsdk:"id"`
+	MaxItems   types.Int64         `tfsdk:"max_items"`
+	Filter     *ListFilterModel    `tfsdk:"filter"`
 	MCPServers []MCPServerListItem `tfsdk:"mcp_servers"`
 }
 
@@ -51,6 +53,10 @@ func (d *MCPServersListDataSource) Schema(ctx context.Context, req datasource.Sc
 				Description: "Placeholder identifier.",
 				Computed:    true,
 			},
+			"max_items": schema.Int64Attribute{
+				Description: "Maximum number of MCP servers to return. All MCP servers are returned when unset.",
+				Optional:    true,
+			},
 			"mcp_servers": schema.ListNestedAttribute{
 				Description: "List of MCP servers.",
 				Computed:    true,
@@ -108,6 +114,9 @@ func (d *MCPServersListDataSource) Schema(ctx context.Context, req datasource.Sc
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock(),
+		},
 	}
 }
 
@@ -136,21 +145,26 @@ func (d *MCPServersListDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
+	filter, diags := newListFilter(ctx, data.Filter, listFilterFields{
+		Name:      "server_name",
+		Alias:     "alias",
+		CreatedAt: "created_at",
+	}, "litellm_mcp_servers")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	endpoint := "/v1/mcp/server"
 
-	var result []interface{}
-	if err := d.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
-		// Try parsing as object with data field
-		var objResult map[string]interface{}
-		if err2 := d.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &objResult); err2 != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list MCP servers: %s", err))
-			return
-		}
-		if dataArr, ok := objResult["data"].([]interface{}); ok {
-			result = dataArr
-		} else if serversArr, ok := objResult["servers"].([]interface{}); ok {
-			result = serversArr
-		}
+	result, err := d.client.ListAll(ctx, endpoint, ListOptions{
+		ItemsKeys: []string{"data", "servers"},
+		MaxItems:  int(data.MaxItems.ValueInt64()),
+		Filter:    filter.Match,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list MCP servers: %s", err))
+		return
 	}
 
 	// Set placeholder ID
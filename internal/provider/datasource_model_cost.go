@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ModelCostDataSource{}
+
+func NewModelCostDataSource() datasource.DataSource {
+	return &ModelCostDataSource{}
+}
+
+type ModelCostDataSource struct {
+	client *Client
+}
+
+type ModelCostDataSourceModel struct {
+	ID                          types.String  `tfsdk:"id"`
+	Model                       types.String  `tfsdk:"model"`
+	CustomLLMProvider           types.String  `tfsdk:"custom_llm_provider"`
+	Key                         types.String  `tfsdk:"key"`
+	LiteLLMProvider             types.String  `tfsdk:"litellm_provider"`
+	Mode                        types.String  `tfsdk:"mode"`
+	InputCostPerToken           types.Float64 `tfsdk:"input_cost_per_token"`
+	OutputCostPerToken          types.Float64 `tfsdk:"output_cost_per_token"`
+	InputCostPerMillionTokens   types.Float64 `tfsdk:"input_cost_per_million_tokens"`
+	OutputCostPerMillionTokens  types.Float64 `tfsdk:"output_cost_per_million_tokens"`
+	CacheReadInputTokenCost     types.Float64 `tfsdk:"cache_read_input_token_cost"`
+	CacheCreationInputTokenCost types.Float64 `tfsdk:"cache_creation_input_token_cost"`
+	MaxTokens                   types.Int64   `tfsdk:"max_tokens"`
+	MaxInputTokens              types.Int64   `tfsdk:"max_input_tokens"`
+	MaxOutputTokens             types.Int64   `tfsdk:"max_output_tokens"`
+	SupportsVision              types.Bool    `tfsdk:"supports_vision"`
+	SupportsFunctionCalling     types.Bool    `tfsdk:"supports_function_calling"`
+	SupportsReasoning           types.Bool    `tfsdk:"supports_reasoning"`
+	SupportsPromptCaching       types.Bool    `tfsdk:"supports_prompt_caching"`
+	EntryJSON                   types.String  `tfsdk:"entry_json"`
+}
+
+func (d *ModelCostDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_cost"
+}
+
+func (d *ModelCostDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the entry of a model in LiteLLM's model cost map, the costs and limits the proxy applies to models without custom pricing.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Key of the cost map entry.",
+				Computed:    true,
+			},
+			"model": schema.StringAttribute{
+				Description: "Model to look up, e.g. gpt-4o or azure/gpt-4o.",
+				Required:    true,
+			},
+			"custom_llm_provider": schema.StringAttribute{
+				Description: "Provider of the model. When set, the provider/model entry is preferred over the model entry, as for litellm_model.",
+				Optional:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "Key of the cost map entry that was found.",
+				Computed:    true,
+			},
+			"litellm_provider": schema.StringAttribute{
+				Description: "Provider the entry belongs to.",
+				Computed:    true,
+			},
+			"mode": schema.StringAttribute{
+				Description: "Mode of the model, e.g. chat or embedding.",
+				Computed:    true,
+			},
+			"input_cost_per_token": schema.Float64Attribute{
+				Description: "Cost per input token.",
+				Computed:    true,
+			},
+			"output_cost_per_token": schema.Float64Attribute{
+				Description: "Cost per output token.",
+				Computed:    true,
+			},
+			"input_cost_per_million_tokens": schema.Float64Attribute{
+				Description: "Cost per million input tokens.",
+				Computed:    true,
+			},
+			"output_cost_per_million_tokens": schema.Float64Attribute{
+				Description: "Cost per million output tokens.",
+				Computed:    true,
+			},
+			"cache_read_input_token_cost": schema.Float64Attribute{
+				Description: "Cost per input token read from the prompt cache.",
+				Computed:    true,
+			},
+			"cache_creation_input_token_cost": schema.Float64Attribute{
+				Description: "Cost per input token written to the prompt cache.",
+				Computed:    true,
+			},
+			"max_tokens": schema.Int64Attribute{
+				Description: "Maximum number of tokens the model handles.",
+				Computed:    true,
+			},
+			"max_input_tokens": schema.Int64Attribute{
+				Description: "Maximum number of input tokens.",
+				Computed:    true,
+			},
+			"max_output_tokens": schema.Int64Attribute{
+				Description: "Maximum number of output tokens.",
+				Computed:    true,
+			},
+			"supports_vision": schema.BoolAttribute{
+				Description: "Whether the model accepts image input.",
+				Computed:    true,
+			},
+			"supports_function_calling": schema.BoolAttribute{
+				Description: "Whether the model supports function calling.",
+				Computed:    true,
+			},
+			"supports_reasoning": schema.BoolAttribute{
+				Description: "Whether the model supports reasoning.",
+				Computed:    true,
+			},
+			"supports_prompt_caching": schema.BoolAttribute{
+				Description: "Whether the model supports prompt caching.",
+				Computed:    true,
+			},
+			"entry_json": schema.StringAttribute{
+				Description: "The full cost map entry as JSON, for fields without an attribute. Use jsondecode() to read it.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ModelCostDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ModelCostDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ModelCostDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	costMap, err := d.client.ModelCostMap(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model cost map: %s", err))
+		return
+	}
+
+	key, entry, ok := lookupModelCost(costMap, data.CustomLLMProvider.ValueString(), data.Model.ValueString())
+	if !ok {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Model %s not found in the model cost map", data.Model.ValueString()))
+		return
+	}
+
+	data.ID = types.StringValue(key)
+	data.Key = types.StringValue(key)
+
+	stringFields := map[string]*types.String{
+		"litellm_provider": &data.LiteLLMProvider,
+		"mode":             &data.Mode,
+	}
+	for field, target := range stringFields {
+		if v, ok := entry[field].(string); ok && v != "" {
+			*target = types.StringValue(v)
+		} else {
+			*target = types.StringNull()
+		}
+	}
+
+	floatFields := map[string]*types.Float64{
+		"input_cost_per_token":            &data.InputCostPerToken,
+		"output_cost_per_token":           &data.OutputCostPerToken,
+		"cache_read_input_token_cost":     &data.CacheReadInputTokenCost,
+		"cache_creation_input_token_cost": &data.CacheCreationInputTokenCost,
+	}
+	for field, target := range floatFields {
+		if v, ok := entry[field].(float64); ok {
+			*target = types.Float64Value(v)
+		} else {
+			*target = types.Float64Null()
+		}
+	}
+
+	data.InputCostPerMillionTokens = types.Float64Null()
+	if cost, ok := entry["input_cost_per_token"].(float64); ok {
+		data.InputCostPerMillionTokens = types.Float64Value(costPerMillionTokens(cost))
+	}
+	data.OutputCostPerMillionTokens = types.Float64Null()
+	if cost, ok := entry["output_cost_per_token"].(float64); ok {
+		data.OutputCostPerMillionTokens = types.Float64Value(costPerMillionTokens(cost))
+	}
+
+	intFields := map[string]*types.Int64{
+		"max_tokens":        &data.MaxTokens,
+		"max_input_tokens":  &data.MaxInputTokens,
+		"max_output_tokens": &data.MaxOutputTokens,
+	}
+	for field, target := range intFields {
+		if v, ok := entry[field].(float64); ok {
+			*target = types.Int64Value(int64(v))
+		} else {
+			*target = types.Int64Null()
+		}
+	}
+
+	// Capabilities missing from an entry are unsupported
+	boolFields := map[string]*types.Bool{
+		"supports_vision":           &data.SupportsVision,
+		"supports_function_calling": &data.SupportsFunctionCalling,
+		"supports_reasoning":        &data.SupportsReasoning,
+		"supports_prompt_caching":   &data.SupportsPromptCaching,
+	}
+	for field, target := range boolFields {
+		v, _ := entry[field].(bool)
+		*target = types.BoolValue(v)
+	}
+
+	entryJSON, err := json.Marshal(entry)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to encode cost map entry: %s", err))
+		return
+	}
+	data.EntryJSON = types.StringValue(string(entryJSON))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ModelGroupDataSource{}
+
+func NewModelGroupDataSource() datasource.DataSource {
+	return &ModelGroupDataSource{}
+}
+
+type ModelGroupDataSource struct {
+	client *Client
+}
+
+// ModelGroupInfoModel holds the info the proxy merges across every deployment
+// of a model group.
+type ModelGroupInfoModel struct {
+	ModelGroup                      types.String  `tfsdk:"model_group"`
+	Providers                       types.List    `tfsdk:"providers"`
+	Mode                            types.String  `tfsdk:"mode"`
+	MaxInputTokens                  types.Int64   `tfsdk:"max_input_tokens"`
+	MaxOutputTokens                 types.Int64   `tfsdk:"max_output_tokens"`
+	InputCostPerMillionTokens       types.Float64 `tfsdk:"input_cost_per_million_tokens"`
+	OutputCostPerMillionTokens      types.Float64 `tfsdk:"output_cost_per_million_tokens"`
+	TPM                             types.Int64   `tfsdk:"tpm"`
+	RPM                             types.Int64   `tfsdk:"rpm"`
+	SupportsVision                  types.Bool    `tfsdk:"supports_vision"`
+	SupportsFunctionCalling         types.Bool    `tfsdk:"supports_function_calling"`
+	SupportsParallelFunctionCalling types.Bool    `tfsdk:"supports_parallel_function_calling"`
+	SupportsReasoning               types.Bool    `tfsdk:"supports_reasoning"`
+	SupportsWebSearch               types.Bool    `tfsdk:"supports_web_search"`
+	SupportedOpenAIParams           types.List    `tfsdk:"supported_openai_params"`
+	IsPublicModelGroup              types.Bool    `tfsdk:"is_public_model_group"`
+}
+
+type ModelGroupDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+	ModelGroupInfoModel
+}
+
+func (d *ModelGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_group"
+}
+
+func (d *ModelGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := modelGroupInfoAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "The model group name.",
+		Computed:    true,
+	}
+	attributes["model_group"] = schema.StringAttribute{
+		Description: "The model group to look up (the model_name shared by its deployments).",
+		Required:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Retrieves information about a LiteLLM model group, merged across all of its deployments.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *ModelGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ModelGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ModelGroupDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelGroup := data.ModelGroup.ValueString()
+	endpoint := fmt.Sprintf("/model_group/info?model_group=%s", url.QueryEscape(modelGroup))
+
+	var result map[string]interface{}
+	if err := d.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model group info: %s", err))
+		return
+	}
+
+	groups, _ := result["data"].([]interface{})
+
+	var groupInfo map[string]interface{}
+	for _, g := range groups {
+		if info, ok := g.(map[string]interface{}); ok {
+			if name, _ := info["model_group"].(string); name == modelGroup {
+				groupInfo = info
+				break
+			}
+		}
+	}
+
+	if groupInfo == nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Model group %q not found", modelGroup))
+		return
+	}
+
+	data.ID = types.StringValue(modelGroup)
+	data.ModelGroupInfoModel = parseModelGroupInfo(ctx, groupInfo)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// modelGroupInfoAttributes returns the computed attributes shared by the
+// model group and model hub data sources.
+func modelGroupInfoAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"model_group": schema.StringAttribute{
+			Description: "The model group name.",
+			Computed:    true,
+		},
+		"providers": schema.ListAttribute{
+			Description: "Providers serving this model group.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"mode": schema.StringAttribute{
+			Description: "Model mode (chat, embedding, completion, etc.).",
+			Computed:    true,
+		},
+		"max_input_tokens": schema.Int64Attribute{
+			Description: "Maximum input tokens.",
+			Computed:    true,
+		},
+		"max_output_tokens": schema.Int64Attribute{
+			Description: "Maximum output tokens.",
+			Computed:    true,
+		},
+		"input_cost_per_million_tokens": schema.Float64Attribute{
+			Description: "Input cost per million tokens.",
+			Computed:    true,
+		},
+		"output_cost_per_million_tokens": schema.Float64Attribute{
+			Description: "Output cost per million tokens.",
+			Computed:    true,
+		},
+		"tpm": schema.Int64Attribute{
+			Description: "Tokens per minute limit across deployments.",
+			Computed:    true,
+		},
+		"rpm": schema.Int64Attribute{
+			Description: "Requests per minute limit across deployments.",
+			Computed:    true,
+		},
+		"supports_vision": schema.BoolAttribute{
+			Description: "Whether the model group supports vision input.",
+			Computed:    true,
+		},
+		"supports_function_calling": schema.BoolAttribute{
+			Description: "Whether the model group supports function calling.",
+			Computed:    true,
+		},
+		"supports_parallel_function_calling": schema.BoolAttribute{
+			Description: "Whether the model group supports parallel function calling.",
+			Computed:    true,
+		},
+		"supports_reasoning": schema.BoolAttribute{
+			Description: "Whether the model group supports reasoning.",
+			Computed:    true,
+		},
+		"supports_web_search": schema.BoolAttribute{
+			Description: "Whether the model group supports web search.",
+			Computed:    true,
+		},
+		"supported_openai_params": schema.ListAttribute{
+			Description: "OpenAI parameters supported by the model group.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"is_public_model_group": schema.BoolAttribute{
+			Description: "Whether the model group is shown in the public model hub.",
+			Computed:    true,
+		},
+	}
+}
+
+func parseModelGroupInfo(ctx context.Context, info map[string]interface{}) ModelGroupInfoModel {
+	item := ModelGroupInfoModel{
+		Providers:             types.ListNull(types.StringType),
+		SupportedOpenAIParams: types.ListNull(types.StringType),
+	}
+
+	if modelGroup, ok := info["model_group"].(string); ok {
+		item.ModelGroup = types.StringValue(modelGroup)
+	}
+	if mode, ok := info["mode"].(string); ok {
+		item.Mode = types.StringValue(mode)
+	}
+	if maxInput, ok := info["max_input_tokens"].(float64); ok {
+		item.MaxInputTokens = types.Int64Value(int64(maxInput))
+	}
+	if maxOutput, ok := info["max_output_tokens"].(float64); ok {
+		item.MaxOutputTokens = types.Int64Value(int64(maxOutput))
+	}
+	if inputCost, ok := info["input_cost_per_token"].(float64); ok {
+		item.InputCostPerMillionTokens = types.Float64Value(inputCost * 1000000.0)
+	}
+	if outputCost, ok := info["output_cost_per_token"].(float64); ok {
+		item.OutputCostPerMillionTokens = types.Float64Value(outputCost * 1000000.0)
+	}
+	if tpm, ok := info["tpm"].(float64); ok {
+		item.TPM = types.Int64Value(int64(tpm))
+	}
+	if rpm, ok := info["rpm"].(float64); ok {
+		item.RPM = types.Int64Value(int64(rpm))
+	}
+	if v, ok := info["supports_vision"].(bool); ok {
+		item.SupportsVision = types.BoolValue(v)
+	}
+	if v, ok := info["supports_function_calling"].(bool); ok {
+		item.SupportsFunctionCalling = types.BoolValue(v)
+	}
+	if v, ok := info["supports_parallel_function_calling"].(bool); ok {
+		item.SupportsParallelFunctionCalling = types.BoolValue(v)
+	}
+	if v, ok := info["supports_reasoning"].(bool); ok {
+		item.SupportsReasoning = types.BoolValue(v)
+	}
+	if v, ok := info["supports_web_search"].(bool); ok {
+		item.SupportsWebSearch = types.BoolValue(v)
+	}
+	if v, ok := info["is_public_model_group"].(bool); ok {
+		item.IsPublicModelGroup = types.BoolValue(v)
+	}
+
+	if providers, ok := info["providers"].([]interface{}); ok {
+		item.Providers, _ = types.ListValueFrom(ctx, types.StringType, interfaceSliceToStrings(providers))
+	}
+	if params, ok := info["supported_openai_params"].([]interface{}); ok {
+		item.SupportedOpenAIParams, _ = types.ListValueFrom(ctx, types.StringType, interfaceSliceToStrings(params))
+	}
+
+	return item
+}
+
+func interfaceSliceToStrings(values []interface{}) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if str, ok := v.(string); ok {
+			result = append(result, str)
+		}
+	}
+	return result
+}
@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ModelHealthDataSource{}
+
+func NewModelHealthDataSource() datasource.DataSource {
+	return &ModelHealthDataSource{}
+}
+
+type ModelHealthDataSource struct {
+	client *Client
+}
+
+type ModelHealthDataSourceModel struct {
+	ID              types.String            `tfsdk:"id"`
+	ModelID         types.String            `tfsdk:"model_id"`
+	ModelName       types.String            `tfsdk:"model_name"`
+	HistoryLimit    types.Int64             `tfsdk:"history_limit"`
+	AllHealthy      types.Bool              `tfsdk:"all_healthy"`
+	HealthyModels   types.Int64             `tfsdk:"healthy_models"`
+	UnhealthyModels types.Int64             `tfsdk:"unhealthy_models"`
+	Models          []ModelHealthItemModel  `tfsdk:"models"`
+	History         []ModelHealthCheckModel `tfsdk:"history"`
+}
+
+type ModelHealthItemModel struct {
+	ModelHealthCheckModel
+	HealthyCount   types.Int64  `tfsdk:"healthy_count"`
+	UnhealthyCount types.Int64  `tfsdk:"unhealthy_count"`
+	CheckedBy      types.String `tfsdk:"checked_by"`
+}
+
+type ModelHealthCheckModel struct {
+	ModelID        types.String  `tfsdk:"model_id"`
+	ModelName      types.String  `tfsdk:"model_name"`
+	Status         types.String  `tfsdk:"status"`
+	ErrorMessage   types.String  `tfsdk:"error_message"`
+	ResponseTimeMs types.Float64 `tfsdk:"response_time_ms"`
+	CheckedAt      types.String  `tfsdk:"checked_at"`
+}
+
+func (d *ModelHealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_health"
+}
+
+func modelHealthCheckAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"model_id": schema.StringAttribute{
+			Description: "ID of the deployment that was checked.",
+			Computed:    true,
+		},
+		"model_name": schema.StringAttribute{
+			Description: "Public model name of the deployment.",
+			Computed:    true,
+		},
+		"status": schema.StringAttribute{
+			Description: "Result of the check: healthy or unhealthy.",
+			Computed:    true,
+		},
+		"error_message": schema.StringAttribute{
+			Description: "Error returned by the deployment when the check failed.",
+			Computed:    true,
+		},
+		"response_time_ms": schema.Float64Attribute{
+			Description: "Time the check took in milliseconds.",
+			Computed:    true,
+		},
+		"checked_at": schema.StringAttribute{
+			Description: "Time the check ran.",
+			Computed:    true,
+		},
+	}
+}
+
+func (d *ModelHealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	latestAttributes := modelHealthCheckAttributes()
+	latestAttributes["healthy_count"] = schema.Int64Attribute{
+		Description: "Number of healthy endpoints found by the check.",
+		Computed:    true,
+	}
+	latestAttributes["unhealthy_count"] = schema.Int64Attribute{
+		Description: "Number of unhealthy endpoints found by the check.",
+		Computed:    true,
+	}
+	latestAttributes["checked_by"] = schema.StringAttribute{
+		Description: "User or process that ran the check.",
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the latest stored health check of each model deployment, and optionally the check history. Requires health checks to be stored in the proxy database.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier.",
+				Computed:    true,
+			},
+			"model_id": schema.StringAttribute{
+				Description: "Only report on the deployment with this model ID.",
+				Optional:    true,
+			},
+			"model_name": schema.StringAttribute{
+				Description: "Only report on deployments with this public model name.",
+				Optional:    true,
+			},
+			"history_limit": schema.Int64Attribute{
+				Description: "Number of most recent history records to return in history. History is not read when unset.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"all_healthy": schema.BoolAttribute{
+				Description: "Whether the latest check of every reported deployment was healthy. True when no deployment has been checked.",
+				Computed:    true,
+			},
+			"healthy_models": schema.Int64Attribute{
+				Description: "Number of deployments whose latest check was healthy.",
+				Computed:    true,
+			},
+			"unhealthy_models": schema.Int64Attribute{
+				Description: "Number of deployments whose latest check was not healthy.",
+				Computed:    true,
+			},
+			"models": schema.ListNestedAttribute{
+				Description: "Latest health check per deployment, ordered by model name and ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: latestAttributes,
+				},
+			},
+			"history": schema.ListNestedAttribute{
+				Description: "Past health checks, newest first. Empty unless history_limit is set.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: modelHealthCheckAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ModelHealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ModelHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ModelHealthDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelID := data.ModelID.ValueString()
+	modelName := data.ModelName.ValueString()
+
+	var latest map[string]interface{}
+	if err := d.client.DoRequestWithResponse(ctx, "GET", "/health/latest", nil, &latest); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read latest model health checks: %s", err))
+		return
+	}
+
+	// Latest checks are keyed by model ID, falling back to model name
+	var checks []interface{}
+	switch v := latest["latest_health_checks"].(type) {
+	case map[string]interface{}:
+		for _, check := range v {
+			checks = append(checks, check)
+		}
+	case []interface{}:
+		checks = v
+	}
+
+	data.ID = types.StringValue("model_health")
+	data.Models = []ModelHealthItemModel{}
+	healthy, unhealthy := 0, 0
+	for _, c := range checks {
+		checkMap, ok := c.(map[string]interface{})
+		if !ok || !matchesModelHealth(checkMap, modelID, modelName) {
+			continue
+		}
+
+		item := ModelHealthItemModel{
+			ModelHealthCheckModel: modelHealthCheckFromMap(checkMap),
+			HealthyCount:          types.Int64Value(0),
+			UnhealthyCount:        types.Int64Value(0),
+			CheckedBy:             types.StringNull(),
+		}
+		if count, ok := checkMap["healthy_count"].(float64); ok {
+			item.HealthyCount = types.Int64Value(int64(count))
+		}
+		if count, ok := checkMap["unhealthy_count"].(float64); ok {
+			item.UnhealthyCount = types.Int64Value(int64(count))
+		}
+		if checkedBy, ok := checkMap["checked_by"].(string); ok && checkedBy != "" {
+			item.CheckedBy = types.StringValue(checkedBy)
+		}
+
+		if item.Status.ValueString() == "healthy" {
+			healthy++
+		} else {
+			unhealthy++
+		}
+		data.Models = append(data.Models, item)
+	}
+
+	sort.SliceStable(data.Models, func(i, j int) bool {
+		a, b := data.Models[i], data.Models[j]
+		if a.ModelName.ValueString() != b.ModelName.ValueString() {
+			return a.ModelName.ValueString() < b.ModelName.ValueString()
+		}
+		return a.ModelID.ValueString() < b.ModelID.ValueString()
+	})
+
+	data.AllHealthy = types.BoolValue(unhealthy == 0)
+	data.HealthyModels = types.Int64Value(int64(healthy))
+	data.UnhealthyModels = types.Int64Value(int64(unhealthy))
+
+	data.History = []ModelHealthCheckModel{}
+	if !data.HistoryLimit.IsNull() {
+		history, err := d.readHistory(ctx, modelID, modelName, int(data.HistoryLimit.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model health check history: %s", err))
+			return
+		}
+		data.History = history
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readHistory pages through /health/history until limit matching records are
+// found. The endpoint filters by model name only, so a model ID is matched
+// here and may take several pages.
+func (d *ModelHealthDataSource) readHistory(ctx context.Context, modelID, modelName string, limit int) ([]ModelHealthCheckModel, error) {
+	history := []ModelHealthCheckModel{}
+	offset := 0
+
+	for len(history) < limit {
+		params := url.Values{}
+		params.Set("limit", strconv.Itoa(limit))
+		params.Set("offset", strconv.Itoa(offset))
+		if modelName != "" {
+			params.Set("model", modelName)
+		}
+
+		var result map[string]interface{}
+		if err := d.client.DoRequestWithResponse(ctx, "GET", "/health/history?"+params.Encode(), nil, &result); err != nil {
+			return nil, err
+		}
+
+		records, _ := result["health_checks"].([]interface{})
+		for _, r := range records {
+			recordMap, ok := r.(map[string]interface{})
+			if !ok || !matchesModelHealth(recordMap, modelID, modelName) {
+				continue
+			}
+			history = append(history, modelHealthCheckFromMap(recordMap))
+			if len(history) == limit {
+				break
+			}
+		}
+
+		offset += len(records)
+		total, hasTotal := result["total_records"].(float64)
+		if len(records) < limit || modelID == "" || (hasTotal && offset >= int(total)) {
+			break
+		}
+	}
+
+	return history, nil
+}
+
+func matchesModelHealth(check map[string]interface{}, modelID, modelName string) bool {
+	if modelID != "" {
+		if id, _ := check["model_id"].(string); id != modelID {
+			return false
+		}
+	}
+	if modelName != "" {
+		if name, _ := check["model_name"].(string); name != modelName {
+			return false
+		}
+	}
+	return true
+}
+
+func modelHealthCheckFromMap(check map[string]interface{}) ModelHealthCheckModel {
+	item := ModelHealthCheckModel{
+		ResponseTimeMs: types.Float64Null(),
+	}
+
+	stringFields := map[string]*types.String{
+		"model_id":      &item.ModelID,
+		"model_name":    &item.ModelName,
+		"status":        &item.Status,
+		"error_message": &item.ErrorMessage,
+		"checked_at":    &item.CheckedAt,
+	}
+	for key, target := range stringFields {
+		if v, ok := check[key].(string); ok && v != "" {
+			*target = types.StringValue(v)
+		} else {
+			*target = types.StringNull()
+		}
+	}
+
+	if responseTime, ok := check["response_time_ms"].(float64); ok {
+		item.ResponseTimeMs = types.Float64Value(responseTime)
+	}
+
+	return item
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ModelHubDataSource{}
+
+func NewModelHubDataSource() datasource.DataSource {
+	return &ModelHubDataSource{}
+}
+
+type ModelHubDataSource struct {
+	client *Client
+}
+
+type ModelHubDataSourceModel struct {
+	ID          types.String          `tfsdk:"id"`
+	ModelGroups []ModelGroupInfoModel `tfsdk:"model_groups"`
+}
+
+func (d *ModelHubDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_hub"
+}
+
+func (d *ModelHubDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the model groups listed in the LiteLLM public model hub.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier.",
+				Computed:    true,
+			},
+			"model_groups": schema.ListNestedAttribute{
+				Description: "List of public model groups.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: modelGroupInfoAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ModelHubDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ModelHubDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ModelHubDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result []interface{}
+	if err := d.client.DoRequestWithResponse(ctx, "GET", "/public/model_hub", nil, &result); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model hub: %s", err))
+		return
+	}
+
+	// Set placeholder ID
+	data.ID = types.StringValue("model_hub")
+
+	data.ModelGroups = make([]ModelGroupInfoModel, 0, len(result))
+	for _, g := range result {
+		info, ok := g.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		data.ModelGroups = append(data.ModelGroups, parseModelGroupInfo(ctx, info))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
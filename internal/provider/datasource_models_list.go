@@ -30,9 +30,11 @@ type ModelListItem struct {
 }
 
 type ModelsListDataSourceModel struct {
-	ID     types.String    `tfsdk:"id"`
-	TeamID types.String    `tfsdk:"team_id"`
-	Models []ModelListItem `tfsdk:"models"`
+	ID       types.String     `tfsdk:"id"`
+	MaxItems types.Int64      `tfsdk:"max_items"`
+	Filter   *ListFilterModel `tfsdk:"filter"`
+	TeamID   types.String     `tfsdk:"team_id"`
+	Models   []ModelListItem  `tfsdk:"models"`
 }
 
 func (d *ModelsListDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,6 +49,10 @@ func (d *ModelsListDataSource) Schema(ctx context.Context, req datasource.Schema
 				Description: "Placeholder identifier.",
 				Computed:    true,
 			},
+			"max_items": schema.Int64Attribute{
+				Description: "Maximum number of models to return. All models are returned when unset.",
+				Optional:    true,
+			},
 			"team_id": schema.StringAttribute{
 				Description: "Optional team ID to filter models by team.",
 				Optional:    true,
@@ -88,6 +94,9 @@ func (d *ModelsListDataSource) Schema(ctx context.Context, req datasource.Schema
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock(),
+		},
 	}
 }
 
@@ -116,13 +125,27 @@ func (d *ModelsListDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
+	filter, diags := newListFilter(ctx, data.Filter, listFilterFields{
+		Name:      "model_name",
+		Metadata:  "model_info",
+		CreatedAt: "model_info.created_at",
+	}, "litellm_models")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	endpoint := "/model/info"
 	if !data.TeamID.IsNull() && data.TeamID.ValueString() != "" {
 		endpoint = fmt.Sprintf("/model/info?team_id=%s", data.TeamID.ValueString())
 	}
 
-	var result map[string]interface{}
-	if err := d.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
+	modelsData, err := d.client.ListAll(ctx, endpoint, ListOptions{
+		ItemsKeys: []string{"data", "models"},
+		MaxItems:  int(data.MaxItems.ValueInt64()),
+		Filter:    filter.Match,
+	})
+	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list models: %s", err))
 		return
 	}
@@ -130,14 +153,6 @@ func (d *ModelsListDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	// Set placeholder ID
 	data.ID = types.StringValue("models")
 
-	// Parse the response - it may be in "data" array
-	var modelsData []interface{}
-	if dataArr, ok := result["data"].([]interface{}); ok {
-		modelsData = dataArr
-	} else if models, ok := result["models"].([]interface{}); ok {
-		modelsData = models
-	}
-
 	data.Models = make([]ModelListItem, 0, len(modelsData))
 	for _, m := range modelsData {
 		modelMap, ok := m.(map[string]interface{})
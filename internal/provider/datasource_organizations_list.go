@@ -32,6 +32,7 @@ type OrganizationListItem struct {
 type OrganizationsListDataSourceModel struct {
 	ID            types.String           `tfsdk:"id"`
 	OrgAlias      types.String           `tfsdk:"org_alias"`
+	MaxItems      types.Int64            `tfsdk:"max_items"`
 	Organizations []OrganizationListItem `tfsdk:"organizations"`
 }
 
@@ -51,6 +52,10 @@ func (d *OrganizationsListDataSource) Schema(ctx context.Context, req datasource
 				Description: "Optional organization alias to filter by (partial match, case-insensitive).",
 				Optional:    true,
 			},
+			"max_items": schema.Int64Attribute{
+				Description: "Maximum number of organizations to return. All organizations are returned when unset.",
+				Optional:    true,
+			},
 			"organizations": schema.ListNestedAttribute{
 				Description: "List of organizations.",
 				Computed:    true,
@@ -121,8 +126,11 @@ func (d *OrganizationsListDataSource) Read(ctx context.Context, req datasource.R
 		endpoint = fmt.Sprintf("/organization/list?org_alias=%s", data.OrgAlias.ValueString())
 	}
 
-	var result map[string]interface{}
-	if err := d.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
+	orgsData, err := d.client.ListAll(ctx, endpoint, ListOptions{
+		ItemsKeys: []string{"organizations", "data"},
+		MaxItems:  int(data.MaxItems.ValueInt64()),
+	})
+	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list organizations: %s", err))
 		return
 	}
@@ -130,14 +138,6 @@ func (d *OrganizationsListDataSource) Read(ctx context.Context, req datasource.R
 	// Set placeholder ID
 	data.ID = types.StringValue("organizations")
 
-	// Parse the response
-	var orgsData []interface{}
-	if orgs, ok := result["organizations"].([]interface{}); ok {
-		orgsData = orgs
-	} else if dataArr, ok := result["data"].([]interface{}); ok {
-		orgsData = dataArr
-	}
-
 	data.Organizations = make([]OrganizationListItem, 0, len(orgsData))
 	for _, o := range orgsData {
 		orgMap, ok := o.(map[string]interface{})
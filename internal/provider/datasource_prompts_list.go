@@ -21,8 +21,9 @@ type PromptsListDataSource struct {
 }
 
 type PromptsListDataSourceModel struct {
-	ID      types.String          `tfsdk:"id"`
-	Prompts []PromptListItemModel `tfsdk:"prompts"`
+	ID       types.String          `tfsdk:"id"`
+	MaxItems types.Int64           `tfsdk:"max_items"`
+	Prompts  []PromptListItemModel `tfsdk:"prompts"`
 }
 
 type PromptListItemModel struct {
@@ -47,6 +48,10 @@ func (d *PromptsListDataSource) Schema(ctx context.Context, req datasource.Schem
 				Description: "Placeholder identifier for this data source.",
 				Computed:    true,
 			},
+			"max_items": schema.Int64Attribute{
+				Description: "Maximum number of prompts to return. All prompts are returned when unset.",
+				Optional:    true,
+			},
 			"prompts": schema.ListNestedAttribute{
 				Description: "List of prompts.",
 				Computed:    true,
@@ -112,14 +117,21 @@ func (d *PromptsListDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	var results []map[string]interface{}
-	if err := d.client.DoRequestWithResponse(ctx, "GET", "/prompts/list", nil, &results); err != nil {
+	results, err := d.client.ListAll(ctx, "/prompts/list", ListOptions{
+		ItemsKeys: []string{"prompts", "data"},
+		MaxItems:  int(data.MaxItems.ValueInt64()),
+	})
+	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list prompts: %s", err))
 		return
 	}
 
 	prompts := make([]PromptListItemModel, 0, len(results))
-	for _, result := range results {
+	for _, r := range results {
+		result, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
 		prompt := PromptListItemModel{}
 
 		if promptID, ok := result["prompt_id"].(string); ok {
@@ -0,0 +1,444 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &ProxyConfigDataSource{}
+
+func NewProxyConfigDataSource() datasource.DataSource {
+	return &ProxyConfigDataSource{}
+}
+
+type ProxyConfigDataSource struct{}
+
+type ProxyConfigModelEntry struct {
+	ModelName                   types.String  `tfsdk:"model_name"`
+	ModelID                     types.String  `tfsdk:"model_id"`
+	CustomLLMProvider           types.String  `tfsdk:"custom_llm_provider"`
+	BaseModel                   types.String  `tfsdk:"base_model"`
+	ModelAPIBase                types.String  `tfsdk:"model_api_base"`
+	ModelAPIKey                 types.String  `tfsdk:"model_api_key"`
+	APIVersion                  types.String  `tfsdk:"api_version"`
+	TPM                         types.Int64   `tfsdk:"tpm"`
+	RPM                         types.Int64   `tfsdk:"rpm"`
+	InputCostPerMillionTokens   types.Float64 `tfsdk:"input_cost_per_million_tokens"`
+	OutputCostPerMillionTokens  types.Float64 `tfsdk:"output_cost_per_million_tokens"`
+	LiteLLMCredentialName       types.String  `tfsdk:"litellm_credential_name"`
+	Mode                        types.String  `tfsdk:"mode"`
+	TeamID                      types.String  `tfsdk:"team_id"`
+	AccessGroups                types.List    `tfsdk:"access_groups"`
+	MaxTokens                   types.Int64   `tfsdk:"max_tokens"`
+	MaxInputTokens              types.Int64   `tfsdk:"max_input_tokens"`
+	MaxOutputTokens             types.Int64   `tfsdk:"max_output_tokens"`
+	OtherAttributes             types.Map     `tfsdk:"other_attributes"`
+	AdditionalLiteLLMParamsJSON types.String  `tfsdk:"additional_litellm_params_json"`
+	EnvironmentVariables        types.Map     `tfsdk:"environment_variables"`
+}
+
+type ProxyConfigDataSourceModel struct {
+	ID                   types.String            `tfsdk:"id"`
+	Path                 types.String            `tfsdk:"path"`
+	Content              types.String            `tfsdk:"content"`
+	ModelList            []ProxyConfigModelEntry `tfsdk:"model_list"`
+	EnvironmentVariables types.List              `tfsdk:"environment_variables"`
+	LiteLLMSettingsJSON  types.String            `tfsdk:"litellm_settings_json"`
+	RouterSettingsJSON   types.String            `tfsdk:"router_settings_json"`
+	GeneralSettingsJSON  types.String            `tfsdk:"general_settings_json"`
+}
+
+// proxyConfigEnvPrefix marks values the proxy reads from an environment variable.
+const proxyConfigEnvPrefix = "os.environ/"
+
+func (d *ProxyConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_proxy_config"
+}
+
+func (d *ProxyConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Parses a LiteLLM proxy config.yaml and maps its model_list to litellm_model attributes, to move deployments defined in the file into the database with for_each.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "SHA-256 hash of the parsed YAML.",
+				Computed:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Path of the config.yaml to parse. Exactly one of path and content must be set.",
+				Optional:    true,
+			},
+			"content": schema.StringAttribute{
+				Description: "YAML content to parse. Exactly one of path and content must be set.",
+				Optional:    true,
+			},
+			"model_list": schema.ListNestedAttribute{
+				Description: "Entries of model_list, with their litellm_params and model_info mapped to litellm_model attributes. Values read from os.environ/ are left null and listed in environment_variables.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"model_name": schema.StringAttribute{
+							Description: "Public model name.",
+							Computed:    true,
+						},
+						"model_id": schema.StringAttribute{
+							Description: "Deployment ID from model_info.id.",
+							Computed:    true,
+						},
+						"custom_llm_provider": schema.StringAttribute{
+							Description: "Provider, from custom_llm_provider or the prefix of model.",
+							Computed:    true,
+						},
+						"base_model": schema.StringAttribute{
+							Description: "Model without the provider prefix.",
+							Computed:    true,
+						},
+						"model_api_base": schema.StringAttribute{
+							Description: "api_base of the deployment.",
+							Computed:    true,
+						},
+						"model_api_key": schema.StringAttribute{
+							Description: "api_key of the deployment, when it is in the file.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"api_version": schema.StringAttribute{
+							Description: "API version of the deployment.",
+							Computed:    true,
+						},
+						"tpm": schema.Int64Attribute{
+							Description: "Tokens per minute limit.",
+							Computed:    true,
+						},
+						"rpm": schema.Int64Attribute{
+							Description: "Requests per minute limit.",
+							Computed:    true,
+						},
+						"input_cost_per_million_tokens": schema.Float64Attribute{
+							Description: "input_cost_per_token converted to cost per million tokens.",
+							Computed:    true,
+						},
+						"output_cost_per_million_tokens": schema.Float64Attribute{
+							Description: "output_cost_per_token converted to cost per million tokens.",
+							Computed:    true,
+						},
+						"litellm_credential_name": schema.StringAttribute{
+							Description: "Credential the deployment uses.",
+							Computed:    true,
+						},
+						"mode": schema.StringAttribute{
+							Description: "Mode from model_info, e.g. chat or embedding.",
+							Computed:    true,
+						},
+						"team_id": schema.StringAttribute{
+							Description: "Team from model_info.",
+							Computed:    true,
+						},
+						"access_groups": schema.ListAttribute{
+							Description: "Access groups from model_info.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"max_tokens": schema.Int64Attribute{
+							Description: "max_tokens from model_info.",
+							Computed:    true,
+						},
+						"max_input_tokens": schema.Int64Attribute{
+							Description: "max_input_tokens from model_info.",
+							Computed:    true,
+						},
+						"max_output_tokens": schema.Int64Attribute{
+							Description: "max_output_tokens from model_info.",
+							Computed:    true,
+						},
+						"other_attributes": schema.MapAttribute{
+							Description: "Other litellm_params that litellm_model has an attribute for, keyed by that attribute, e.g. aws_region_name or azure.tenant_id.",
+							Computed:    true,
+							Sensitive:   true,
+							ElementType: types.StringType,
+						},
+						"additional_litellm_params_json": schema.StringAttribute{
+							Description: "litellm_params without a litellm_model attribute, as JSON for jsondecode() into additional_litellm_params.",
+							Computed:    true,
+						},
+						"environment_variables": schema.MapAttribute{
+							Description: "Environment variables the entry reads with os.environ/, keyed by the litellm_model attribute, other_attributes key or litellm_params key they are read into.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"environment_variables": schema.ListAttribute{
+				Description: "Every environment variable the file reads with os.environ/, sorted. These are the values to supply when moving to Terraform.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"litellm_settings_json": schema.StringAttribute{
+				Description: "The litellm_settings section as JSON.",
+				Computed:    true,
+			},
+			"router_settings_json": schema.StringAttribute{
+				Description: "The router_settings section as JSON.",
+				Computed:    true,
+			},
+			"general_settings_json": schema.StringAttribute{
+				Description: "The general_settings section as JSON.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ProxyConfigDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("path"), path.MatchRoot("content")),
+	}
+}
+
+func (d *ProxyConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProxyConfigDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content := []byte(data.Content.ValueString())
+	if !data.Path.IsNull() {
+		var err error
+		content, err = os.ReadFile(data.Path.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("path"), "Unable to Read Proxy Config", err.Error())
+			return
+		}
+	}
+
+	var config map[string]interface{}
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		resp.Diagnostics.AddError("Invalid Proxy Config", fmt.Sprintf("Unable to parse the proxy config as YAML: %s", err))
+		return
+	}
+
+	sum := sha256.Sum256(content)
+	data.ID = types.StringValue(hex.EncodeToString(sum[:]))
+
+	modelList, _ := config["model_list"].([]interface{})
+	data.ModelList = make([]ProxyConfigModelEntry, 0, len(modelList))
+	for i, item := range modelList {
+		entry, ok := item.(map[string]interface{})
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Proxy Config", fmt.Sprintf("model_list[%d] is not a mapping.", i))
+			return
+		}
+		data.ModelList = append(data.ModelList, proxyConfigModelEntry(entry))
+	}
+
+	envVars := map[string]bool{}
+	collectProxyConfigEnvVars(config, envVars)
+	names := make([]string, 0, len(envVars))
+	for name := range envVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	data.EnvironmentVariables, _ = types.ListValueFrom(ctx, types.StringType, names)
+
+	jsonFields := map[string]*types.String{
+		"litellm_settings": &data.LiteLLMSettingsJSON,
+		"router_settings":  &data.RouterSettingsJSON,
+		"general_settings": &data.GeneralSettingsJSON,
+	}
+	for key, field := range jsonFields {
+		*field = types.StringNull()
+		if section, ok := config[key]; ok && section != nil {
+			if b, err := json.Marshal(section); err == nil {
+				*field = types.StringValue(string(b))
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// proxyConfigModelEntry maps a model_list entry to litellm_model attributes.
+func proxyConfigModelEntry(entry map[string]interface{}) ProxyConfigModelEntry {
+	litellmParams, _ := entry["litellm_params"].(map[string]interface{})
+	modelInfo, _ := entry["model_info"].(map[string]interface{})
+
+	result := ProxyConfigModelEntry{
+		ModelName:                   proxyConfigString(entry["model_name"]),
+		ModelID:                     proxyConfigString(modelInfo["id"]),
+		Mode:                        proxyConfigString(modelInfo["mode"]),
+		TeamID:                      proxyConfigString(modelInfo["team_id"]),
+		MaxTokens:                   proxyConfigInt64(modelInfo["max_tokens"]),
+		MaxInputTokens:              proxyConfigInt64(modelInfo["max_input_tokens"]),
+		MaxOutputTokens:             proxyConfigInt64(modelInfo["max_output_tokens"]),
+		AccessGroups:                types.ListNull(types.StringType),
+		AdditionalLiteLLMParamsJSON: types.StringNull(),
+	}
+	if groups, ok := modelInfo["access_groups"].([]interface{}); ok {
+		values := make([]string, 0, len(groups))
+		for _, group := range groups {
+			if s, ok := group.(string); ok {
+				values = append(values, s)
+			}
+		}
+		result.AccessGroups, _ = types.ListValueFrom(context.Background(), types.StringType, values)
+	}
+
+	// The proxy takes the provider from the model prefix unless it is set
+	// explicitly; litellm_model always sends both.
+	model, _ := litellmParams["model"].(string)
+	provider, _ := litellmParams["custom_llm_provider"].(string)
+	baseModel := model
+	if prefix, rest, found := strings.Cut(model, "/"); found {
+		if provider == "" {
+			provider = prefix
+		}
+		if prefix == provider {
+			baseModel = rest
+		}
+	}
+	result.CustomLLMProvider = proxyConfigString(provider)
+	result.BaseModel = proxyConfigString(baseModel)
+
+	stringFields := map[string]*types.String{
+		"api_base":                &result.ModelAPIBase,
+		"api_key":                 &result.ModelAPIKey,
+		"api_version":             &result.APIVersion,
+		"litellm_credential_name": &result.LiteLLMCredentialName,
+	}
+	int64Fields := map[string]*types.Int64{
+		"tpm": &result.TPM,
+		"rpm": &result.RPM,
+	}
+	costFields := map[string]*types.Float64{
+		"input_cost_per_token":  &result.InputCostPerMillionTokens,
+		"output_cost_per_token": &result.OutputCostPerMillionTokens,
+	}
+	for _, field := range stringFields {
+		*field = types.StringNull()
+	}
+	for _, field := range int64Fields {
+		*field = types.Int64Null()
+	}
+	for _, field := range costFields {
+		*field = types.Float64Null()
+	}
+
+	envVars := map[string]string{}
+	otherAttributes := map[string]string{}
+	additional := map[string]interface{}{}
+	for key, value := range litellmParams {
+		if key == "model" || key == "custom_llm_provider" {
+			continue
+		}
+
+		attribute, managed := modelManagedLiteLLMParams[key]
+		if !managed {
+			attribute, managed = modelProviderParamAttribute(key)
+		}
+		if !managed {
+			attribute = key
+		}
+
+		if s, ok := value.(string); ok && strings.HasPrefix(s, proxyConfigEnvPrefix) {
+			envVars[attribute] = strings.TrimPrefix(s, proxyConfigEnvPrefix)
+			continue
+		}
+
+		switch {
+		case stringFields[key] != nil:
+			*stringFields[key] = proxyConfigString(value)
+		case int64Fields[key] != nil:
+			*int64Fields[key] = proxyConfigInt64(value)
+		case costFields[key] != nil:
+			if f, ok := proxyConfigFloat(value); ok {
+				*costFields[key] = types.Float64Value(costPerMillionTokens(f))
+			}
+		case managed:
+			otherAttributes[attribute] = proxyConfigText(value)
+		default:
+			additional[key] = value
+		}
+	}
+
+	result.OtherAttributes, _ = types.MapValueFrom(context.Background(), types.StringType, otherAttributes)
+	result.EnvironmentVariables, _ = types.MapValueFrom(context.Background(), types.StringType, envVars)
+	if len(additional) > 0 {
+		if b, err := json.Marshal(additional); err == nil {
+			result.AdditionalLiteLLMParamsJSON = types.StringValue(string(b))
+		}
+	}
+
+	return result
+}
+
+// collectProxyConfigEnvVars adds the environment variables referenced
+// anywhere in value to names.
+func collectProxyConfigEnvVars(value interface{}, names map[string]bool) {
+	switch v := value.(type) {
+	case string:
+		if strings.HasPrefix(v, proxyConfigEnvPrefix) {
+			names[strings.TrimPrefix(v, proxyConfigEnvPrefix)] = true
+		}
+	case map[string]interface{}:
+		for _, item := range v {
+			collectProxyConfigEnvVars(item, names)
+		}
+	case []interface{}:
+		for _, item := range v {
+			collectProxyConfigEnvVars(item, names)
+		}
+	}
+}
+
+func proxyConfigString(value interface{}) types.String {
+	if s, ok := value.(string); ok && s != "" && !strings.HasPrefix(s, proxyConfigEnvPrefix) {
+		return types.StringValue(s)
+	}
+	return types.StringNull()
+}
+
+func proxyConfigInt64(value interface{}) types.Int64 {
+	if f, ok := proxyConfigFloat(value); ok {
+		return types.Int64Value(int64(f))
+	}
+	return types.Int64Null()
+}
+
+func proxyConfigFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// proxyConfigText renders a scalar as a string and anything else as JSON.
+func proxyConfigText(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case map[string]interface{}, []interface{}:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RAGQueryDataSource{}
+
+func NewRAGQueryDataSource() datasource.DataSource {
+	return &RAGQueryDataSource{}
+}
+
+type RAGQueryDataSource struct {
+	client *Client
+}
+
+type RAGQueryDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Model             types.String `tfsdk:"model"`
+	Query             types.String `tfsdk:"query"`
+	VectorStoreID     types.String `tfsdk:"vector_store_id"`
+	CustomLLMProvider types.String `tfsdk:"custom_llm_provider"`
+	TopK              types.Int64  `tfsdk:"top_k"`
+	RerankModel       types.String `tfsdk:"rerank_model"`
+	RerankTopN        types.Int64  `tfsdk:"rerank_top_n"`
+	Response          types.String `tfsdk:"response"`
+	RawResponse       types.String `tfsdk:"raw_response"`
+}
+
+func (d *RAGQueryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rag_query"
+}
+
+func (d *RAGQueryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a retrieval-augmented query against a vector store. Useful for smoke-testing retrieval in CI.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the completion returned by the proxy.",
+				Computed:    true,
+			},
+			"model": schema.StringAttribute{
+				Description: "Model used to generate the answer.",
+				Required:    true,
+			},
+			"query": schema.StringAttribute{
+				Description: "Question to ask.",
+				Required:    true,
+			},
+			"vector_store_id": schema.StringAttribute{
+				Description: "ID of the vector store to search.",
+				Required:    true,
+			},
+			"custom_llm_provider": schema.StringAttribute{
+				Description: "Provider of the vector store. Defaults to openai.",
+				Optional:    true,
+			},
+			"top_k": schema.Int64Attribute{
+				Description: "Number of results to retrieve from the vector store.",
+				Optional:    true,
+			},
+			"rerank_model": schema.StringAttribute{
+				Description: "Model used to rerank the retrieved results. Reranking is skipped when unset.",
+				Optional:    true,
+			},
+			"rerank_top_n": schema.Int64Attribute{
+				Description: "Number of results to keep after reranking.",
+				Optional:    true,
+			},
+			"response": schema.StringAttribute{
+				Description: "The generated answer.",
+				Computed:    true,
+			},
+			"raw_response": schema.StringAttribute{
+				Description: "The full response from the proxy as a JSON string.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *RAGQueryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RAGQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RAGQueryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customLLMProvider := "openai"
+	if !data.CustomLLMProvider.IsNull() && data.CustomLLMProvider.ValueString() != "" {
+		customLLMProvider = data.CustomLLMProvider.ValueString()
+	}
+
+	retrievalConfig := map[string]interface{}{
+		"vector_store_id":     data.VectorStoreID.ValueString(),
+		"custom_llm_provider": customLLMProvider,
+	}
+	if !data.TopK.IsNull() {
+		retrievalConfig["top_k"] = data.TopK.ValueInt64()
+	}
+
+	queryReq := map[string]interface{}{
+		"model": data.Model.ValueString(),
+		"messages": []map[string]interface{}{
+			{"role": "user", "content": data.Query.ValueString()},
+		},
+		"retrieval_config": retrievalConfig,
+	}
+
+	if !data.RerankModel.IsNull() && data.RerankModel.ValueString() != "" {
+		rerank := map[string]interface{}{
+			"enabled": true,
+			"model":   data.RerankModel.ValueString(),
+		}
+		if !data.RerankTopN.IsNull() {
+			rerank["top_n"] = data.RerankTopN.ValueInt64()
+		}
+		queryReq["rerank"] = rerank
+	}
+
+	var result map[string]interface{}
+	if err := d.client.DoRequestWithResponse(ctx, "POST", "/v1/rag/query", queryReq, &result); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run RAG query: %s", err))
+		return
+	}
+
+	if id, ok := result["id"].(string); ok {
+		data.ID = types.StringValue(id)
+	} else {
+		data.ID = data.VectorStoreID
+	}
+
+	data.Response = types.StringValue("")
+	if choices, ok := result["choices"].([]interface{}); ok && len(choices) > 0 {
+		if choice, ok := choices[0].(map[string]interface{}); ok {
+			if message, ok := choice["message"].(map[string]interface{}); ok {
+				if content, ok := message["content"].(string); ok {
+					data.Response = types.StringValue(content)
+				}
+			}
+		}
+	}
+
+	rawJSON, err := json.Marshal(result)
+	if err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to encode RAG query response: %s", err))
+		return
+	}
+	data.RawResponse = types.StringValue(string(rawJSON))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
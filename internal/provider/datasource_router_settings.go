@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RouterSettingsDataSource{}
+
+func NewRouterSettingsDataSource() datasource.DataSource {
+	return &RouterSettingsDataSource{}
+}
+
+type RouterSettingsDataSource struct {
+	client *Client
+}
+
+type RouterSettingsDataSourceModel struct {
+	ID                          types.String               `tfsdk:"id"`
+	RoutingStrategy             types.String               `tfsdk:"routing_strategy"`
+	NumRetries                  types.Int64                `tfsdk:"num_retries"`
+	Timeout                     types.Float64              `tfsdk:"timeout"`
+	CooldownTime                types.Float64              `tfsdk:"cooldown_time"`
+	AllowedFails                types.Int64                `tfsdk:"allowed_fails"`
+	CurrentValues               types.String               `tfsdk:"current_values"`
+	RoutingStrategies           types.List                 `tfsdk:"routing_strategies"`
+	RoutingStrategyDescriptions types.Map                  `tfsdk:"routing_strategy_descriptions"`
+	Fields                      []RouterSettingsFieldModel `tfsdk:"fields"`
+}
+
+type RouterSettingsFieldModel struct {
+	FieldName        types.String `tfsdk:"field_name"`
+	FieldType        types.String `tfsdk:"field_type"`
+	FieldDescription types.String `tfsdk:"field_description"`
+	FieldDefault     types.String `tfsdk:"field_default"`
+	Options          types.List   `tfsdk:"options"`
+	UIFieldName      types.String `tfsdk:"ui_field_name"`
+	Link             types.String `tfsdk:"link"`
+}
+
+func (d *RouterSettingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_router_settings"
+}
+
+func (d *RouterSettingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the proxy's current router settings and the metadata of every configurable router field.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier.",
+				Computed:    true,
+			},
+			"routing_strategy": schema.StringAttribute{
+				Description: "The proxy's current routing strategy.",
+				Computed:    true,
+			},
+			"num_retries": schema.Int64Attribute{
+				Description: "The proxy's current number of retries.",
+				Computed:    true,
+			},
+			"timeout": schema.Float64Attribute{
+				Description: "The proxy's current request timeout in seconds.",
+				Computed:    true,
+			},
+			"cooldown_time": schema.Float64Attribute{
+				Description: "The proxy's current deployment cooldown time in seconds.",
+				Computed:    true,
+			},
+			"allowed_fails": schema.Int64Attribute{
+				Description: "The proxy's current number of allowed failures before cooldown.",
+				Computed:    true,
+			},
+			"current_values": schema.StringAttribute{
+				Description: "All current router setting values as a JSON string.",
+				Computed:    true,
+			},
+			"routing_strategies": schema.ListAttribute{
+				Description: "Routing strategies supported by the proxy.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"routing_strategy_descriptions": schema.MapAttribute{
+				Description: "Description of each routing strategy.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"fields": schema.ListNestedAttribute{
+				Description: "Configurable router settings.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field_name": schema.StringAttribute{
+							Description: "Setting name.",
+							Computed:    true,
+						},
+						"field_type": schema.StringAttribute{
+							Description: "Setting type.",
+							Computed:    true,
+						},
+						"field_description": schema.StringAttribute{
+							Description: "Setting description.",
+							Computed:    true,
+						},
+						"field_default": schema.StringAttribute{
+							Description: "Default value as a JSON string.",
+							Computed:    true,
+						},
+						"options": schema.ListAttribute{
+							Description: "Allowed values, for settings that take one of a fixed set.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"ui_field_name": schema.StringAttribute{
+							Description: "Display name used in the Admin UI.",
+							Computed:    true,
+						},
+						"link": schema.StringAttribute{
+							Description: "Link to the setting's documentation.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RouterSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RouterSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RouterSettingsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var settings map[string]interface{}
+	if err := d.client.DoRequestWithResponse(ctx, "GET", "/router/settings", nil, &settings); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read router settings: %s", err))
+		return
+	}
+
+	var fields map[string]interface{}
+	if err := d.client.DoRequestWithResponse(ctx, "GET", "/router/fields", nil, &fields); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read router fields: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("router_settings")
+
+	currentValues, _ := settings["current_values"].(map[string]interface{})
+	if currentValues == nil {
+		currentValues = map[string]interface{}{}
+	}
+	if strategy, ok := currentValues["routing_strategy"].(string); ok {
+		data.RoutingStrategy = types.StringValue(strategy)
+	}
+	if numRetries, ok := currentValues["num_retries"].(float64); ok {
+		data.NumRetries = types.Int64Value(int64(numRetries))
+	}
+	if timeout, ok := currentValues["timeout"].(float64); ok {
+		data.Timeout = types.Float64Value(timeout)
+	}
+	if cooldown, ok := currentValues["cooldown_time"].(float64); ok {
+		data.CooldownTime = types.Float64Value(cooldown)
+	}
+	if allowedFails, ok := currentValues["allowed_fails"].(float64); ok {
+		data.AllowedFails = types.Int64Value(int64(allowedFails))
+	}
+
+	currentJSON, err := json.Marshal(currentValues)
+	if err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to encode router settings: %s", err))
+		return
+	}
+	data.CurrentValues = types.StringValue(string(currentJSON))
+
+	descriptions := make(map[string]string)
+	if descs, ok := fields["routing_strategy_descriptions"].(map[string]interface{}); ok {
+		for k, v := range descs {
+			if str, ok := v.(string); ok {
+				descriptions[k] = str
+			}
+		}
+	}
+	data.RoutingStrategyDescriptions, _ = types.MapValueFrom(ctx, types.StringType, descriptions)
+
+	data.RoutingStrategies = types.ListNull(types.StringType)
+	data.Fields = []RouterSettingsFieldModel{}
+	if fieldList, ok := fields["fields"].([]interface{}); ok {
+		for _, f := range fieldList {
+			fieldMap, ok := f.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			field := RouterSettingsFieldModel{
+				Options:      types.ListNull(types.StringType),
+				FieldDefault: types.StringNull(),
+			}
+			if name, ok := fieldMap["field_name"].(string); ok {
+				field.FieldName = types.StringValue(name)
+			}
+			if fieldType, ok := fieldMap["field_type"].(string); ok {
+				field.FieldType = types.StringValue(fieldType)
+			}
+			if desc, ok := fieldMap["field_description"].(string); ok {
+				field.FieldDescription = types.StringValue(desc)
+			}
+			if uiName, ok := fieldMap["ui_field_name"].(string); ok {
+				field.UIFieldName = types.StringValue(uiName)
+			}
+			if link, ok := fieldMap["link"].(string); ok {
+				field.Link = types.StringValue(link)
+			}
+			if def, ok := fieldMap["field_default"]; ok && def != nil {
+				if defJSON, err := json.Marshal(def); err == nil {
+					field.FieldDefault = types.StringValue(string(defJSON))
+				}
+			}
+			if options, ok := fieldMap["options"].([]interface{}); ok {
+				field.Options, _ = types.ListValueFrom(ctx, types.StringType, interfaceSliceToStrings(options))
+				if field.FieldName.ValueString() == "routing_strategy" {
+					data.RoutingStrategies = field.Options
+				}
+			}
+
+			data.Fields = append(data.Fields, field)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
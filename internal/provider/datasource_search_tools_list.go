@@ -30,6 +30,7 @@ type SearchToolListItem struct {
 
 type SearchToolsListDataSourceModel struct {
 	ID          types.String         `tfsdk:"id"`
+	MaxItems    types.Int64          `tfsdk:"max_items"`
 	SearchTools []SearchToolListItem `tfsdk:"search_tools"`
 }
 
@@ -45,6 +46,10 @@ func (d *SearchToolsListDataSource) Schema(ctx context.Context, req datasource.S
 				Description: "Placeholder identifier.",
 				Computed:    true,
 			},
+			"max_items": schema.Int64Attribute{
+				Description: "Maximum number of search tools to return. All search tools are returned when unset.",
+				Optional:    true,
+			},
 			"search_tools": schema.ListNestedAttribute{
 				Description: "List of search tools.",
 				Computed:    true,
@@ -108,19 +113,13 @@ func (d *SearchToolsListDataSource) Read(ctx context.Context, req datasource.Rea
 
 	endpoint := "/search_tools/list"
 
-	var result []interface{}
-	if err := d.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
-		// Try parsing as object with data field
-		var objResult map[string]interface{}
-		if err2 := d.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &objResult); err2 != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list search tools: %s", err))
-			return
-		}
-		if dataArr, ok := objResult["data"].([]interface{}); ok {
-			result = dataArr
-		} else if toolsArr, ok := objResult["search_tools"].([]interface{}); ok {
-			result = toolsArr
-		}
+	result, err := d.client.ListAll(ctx, endpoint, ListOptions{
+		ItemsKeys: []string{"search_tools", "data"},
+		MaxItems:  int(data.MaxItems.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list search tools: %s", err))
+		return
 	}
 
 	// Set placeholder ID
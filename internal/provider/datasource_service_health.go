@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServiceHealthDataSource{}
+
+func NewServiceHealthDataSource() datasource.DataSource {
+	return &ServiceHealthDataSource{}
+}
+
+type ServiceHealthDataSource struct {
+	client *Client
+}
+
+type ServiceHealthDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Service types.String `tfsdk:"service"`
+	Healthy types.Bool   `tfsdk:"healthy"`
+	Status  types.String `tfsdk:"status"`
+	Message types.String `tfsdk:"message"`
+}
+
+func (d *ServiceHealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_health"
+}
+
+func (d *ServiceHealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks that the proxy can reach an integrated service such as a logging callback or alerting channel. A failing service is reported through the attributes rather than as an error.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Name of the checked service.",
+				Computed:    true,
+			},
+			"service": schema.StringAttribute{
+				Description: "Service to check. Valid values: slack_budget_alerts, langfuse, langfuse_otel, slack, openmeter, webhook, email, braintrust, datadog, generic_api, arize, sqs.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"slack_budget_alerts", "langfuse", "langfuse_otel", "slack", "openmeter", "webhook",
+						"email", "braintrust", "datadog", "generic_api", "arize", "sqs",
+					),
+				},
+			},
+			"healthy": schema.BoolAttribute{
+				Description: "Whether the check succeeded.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status reported by the proxy, e.g. success.",
+				Computed:    true,
+			},
+			"message": schema.StringAttribute{
+				Description: "Message returned by the check, or the reason it failed.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ServiceHealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ServiceHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServiceHealthDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := data.Service.ValueString()
+
+	var result map[string]interface{}
+	code, err := d.client.DoRequestWithStatus(ctx, "GET", "/health/services?service="+url.QueryEscape(service), nil, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check service %s: %s", service, err))
+		return
+	}
+
+	data.ID = types.StringValue(service)
+	data.Healthy = types.BoolValue(isHealthyStatusCode(code))
+	data.Status = types.StringNull()
+	data.Message = types.StringNull()
+
+	if status, ok := result["status"].(string); ok && status != "" {
+		data.Status = types.StringValue(status)
+		if status != "success" && status != "healthy" {
+			data.Healthy = types.BoolValue(false)
+		}
+	}
+
+	if data.Healthy.ValueBool() {
+		if message, ok := result["message"].(string); ok && message != "" {
+			data.Message = types.StringValue(message)
+		}
+	} else {
+		data.Message = types.StringValue(healthErrorMessage(code, result))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
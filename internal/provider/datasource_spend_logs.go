@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SpendLogsDataSource{}
+
+func NewSpendLogsDataSource() datasource.DataSource {
+	return &SpendLogsDataSource{}
+}
+
+type SpendLogsDataSource struct {
+	client *Client
+}
+
+type SpendLogsDataSourceModel struct {
+	ID         types.String        `tfsdk:"id"`
+	StartDate  types.String        `tfsdk:"start_date"`
+	EndDate    types.String        `tfsdk:"end_date"`
+	APIKey     types.String        `tfsdk:"api_key"`
+	KeyAlias   types.String        `tfsdk:"key_alias"`
+	UserID     types.String        `tfsdk:"user_id"`
+	TeamID     types.String        `tfsdk:"team_id"`
+	EndUser    types.String        `tfsdk:"end_user"`
+	Model      types.String        `tfsdk:"model"`
+	Status     types.String        `tfsdk:"status"`
+	MinSpend   types.Float64       `tfsdk:"min_spend"`
+	MaxSpend   types.Float64       `tfsdk:"max_spend"`
+	MaxItems   types.Int64         `tfsdk:"max_items"`
+	TotalSpend types.Float64       `tfsdk:"total_spend"`
+	Logs       []SpendLogItemModel `tfsdk:"logs"`
+}
+
+type SpendLogItemModel struct {
+	RequestID        types.String  `tfsdk:"request_id"`
+	CallType         types.String  `tfsdk:"call_type"`
+	Model            types.String  `tfsdk:"model"`
+	ModelGroup       types.String  `tfsdk:"model_group"`
+	Provider         types.String  `tfsdk:"custom_llm_provider"`
+	APIKey           types.String  `tfsdk:"api_key"`
+	User             types.String  `tfsdk:"user"`
+	TeamID           types.String  `tfsdk:"team_id"`
+	EndUser          types.String  `tfsdk:"end_user"`
+	Status           types.String  `tfsdk:"status"`
+	Spend            types.Float64 `tfsdk:"spend"`
+	PromptTokens     types.Int64   `tfsdk:"prompt_tokens"`
+	CompletionTokens types.Int64   `tfsdk:"completion_tokens"`
+	TotalTokens      types.Int64   `tfsdk:"total_tokens"`
+	StartTime        types.String  `tfsdk:"start_time"`
+	EndTime          types.String  `tfsdk:"end_time"`
+	RequestTags      types.List    `tfsdk:"request_tags"`
+}
+
+func (d *SpendLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_spend_logs"
+}
+
+func (d *SpendLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves individual request spend logs in a time range, newest first.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier.",
+				Computed:    true,
+			},
+			"start_date": schema.StringAttribute{
+				Description: "Start of the time range, e.g. \"2025-01-01 00:00:00\".",
+				Required:    true,
+			},
+			"end_date": schema.StringAttribute{
+				Description: "End of the time range, e.g. \"2025-01-31 23:59:59\".",
+				Required:    true,
+			},
+			"api_key": schema.StringAttribute{
+				Description: "Only return requests made with this key (hashed token).",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"key_alias": schema.StringAttribute{
+				Description: "Only return requests made with keys that have this alias.",
+				Optional:    true,
+			},
+			"user_id": schema.StringAttribute{
+				Description: "Only return requests made by this user.",
+				Optional:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Description: "Only return requests made by this team.",
+				Optional:    true,
+			},
+			"end_user": schema.StringAttribute{
+				Description: "Only return requests made for this end user.",
+				Optional:    true,
+			},
+			"model": schema.StringAttribute{
+				Description: "Only return requests to this model.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Only return successful or failed requests. Valid values: success, failure.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("success", "failure"),
+				},
+			},
+			"min_spend": schema.Float64Attribute{
+				Description: "Only return requests that cost at least this amount.",
+				Optional:    true,
+			},
+			"max_spend": schema.Float64Attribute{
+				Description: "Only return requests that cost at most this amount.",
+				Optional:    true,
+			},
+			"max_items": schema.Int64Attribute{
+				Description: "Maximum number of logs to return. All logs in the range are returned when unset.",
+				Optional:    true,
+			},
+			"total_spend": schema.Float64Attribute{
+				Description: "Total spend in USD of the returned logs.",
+				Computed:    true,
+			},
+			"logs": schema.ListNestedAttribute{
+				Description: "Spend logs.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"request_id": schema.StringAttribute{
+							Description: "Request ID.",
+							Computed:    true,
+						},
+						"call_type": schema.StringAttribute{
+							Description: "Type of call, e.g. acompletion or aembedding.",
+							Computed:    true,
+						},
+						"model": schema.StringAttribute{
+							Description: "Model the request was sent to.",
+							Computed:    true,
+						},
+						"model_group": schema.StringAttribute{
+							Description: "Model group (public model name) requested.",
+							Computed:    true,
+						},
+						"custom_llm_provider": schema.StringAttribute{
+							Description: "Provider that served the request.",
+							Computed:    true,
+						},
+						"api_key": schema.StringAttribute{
+							Description: "Hashed key the request was made with.",
+							Computed:    true,
+						},
+						"user": schema.StringAttribute{
+							Description: "User the request was made by.",
+							Computed:    true,
+						},
+						"team_id": schema.StringAttribute{
+							Description: "Team the request was made by.",
+							Computed:    true,
+						},
+						"end_user": schema.StringAttribute{
+							Description: "End user the request was made for.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Request status.",
+							Computed:    true,
+						},
+						"spend": schema.Float64Attribute{
+							Description: "Cost of the request in USD.",
+							Computed:    true,
+						},
+						"prompt_tokens": schema.Int64Attribute{
+							Description: "Prompt tokens.",
+							Computed:    true,
+						},
+						"completion_tokens": schema.Int64Attribute{
+							Description: "Completion tokens.",
+							Computed:    true,
+						},
+						"total_tokens": schema.Int64Attribute{
+							Description: "Total tokens.",
+							Computed:    true,
+						},
+						"start_time": schema.StringAttribute{
+							Description: "Time the request started.",
+							Computed:    true,
+						},
+						"end_time": schema.StringAttribute{
+							Description: "Time the request finished.",
+							Computed:    true,
+						},
+						"request_tags": schema.ListAttribute{
+							Description: "Tags attached to the request.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SpendLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SpendLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SpendLogsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := url.Values{}
+	params.Set("start_date", data.StartDate.ValueString())
+	params.Set("end_date", data.EndDate.ValueString())
+	optional := map[string]types.String{
+		"api_key":       data.APIKey,
+		"key_alias":     data.KeyAlias,
+		"user_id":       data.UserID,
+		"team_id":       data.TeamID,
+		"end_user":      data.EndUser,
+		"model":         data.Model,
+		"status_filter": data.Status,
+	}
+	for param, value := range optional {
+		if !value.IsNull() && value.ValueString() != "" {
+			params.Set(param, value.ValueString())
+		}
+	}
+	if !data.MinSpend.IsNull() {
+		params.Set("min_spend", strconv.FormatFloat(data.MinSpend.ValueFloat64(), 'f', -1, 64))
+	}
+	if !data.MaxSpend.IsNull() {
+		params.Set("max_spend", strconv.FormatFloat(data.MaxSpend.ValueFloat64(), 'f', -1, 64))
+	}
+
+	logs, err := d.client.ListAll(ctx, "/spend/logs/v2?"+params.Encode(), ListOptions{
+		ItemsKeys: []string{"data"},
+		PageParam: "page",
+		SizeParam: "page_size",
+		MaxItems:  int(data.MaxItems.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read spend logs: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("spend_logs")
+
+	total := 0.0
+	data.Logs = make([]SpendLogItemModel, 0, len(logs))
+	for _, l := range logs {
+		logMap, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		item := SpendLogItemModel{
+			Spend:            types.Float64Value(0),
+			PromptTokens:     types.Int64Value(0),
+			CompletionTokens: types.Int64Value(0),
+			TotalTokens:      types.Int64Value(0),
+		}
+
+		stringFields := map[string]*types.String{
+			"request_id":          &item.RequestID,
+			"call_type":           &item.CallType,
+			"model":               &item.Model,
+			"model_group":         &item.ModelGroup,
+			"custom_llm_provider": &item.Provider,
+			"api_key":             &item.APIKey,
+			"user":                &item.User,
+			"team_id":             &item.TeamID,
+			"end_user":            &item.EndUser,
+			"status":              &item.Status,
+			"startTime":           &item.StartTime,
+			"endTime":             &item.EndTime,
+		}
+		for key, target := range stringFields {
+			if v, ok := logMap[key].(string); ok && v != "" {
+				*target = types.StringValue(v)
+			} else {
+				*target = types.StringNull()
+			}
+		}
+
+		if spend, ok := logMap["spend"].(float64); ok {
+			item.Spend = types.Float64Value(spend)
+			total += spend
+		}
+		if tokens, ok := logMap["prompt_tokens"].(float64); ok {
+			item.PromptTokens = types.Int64Value(int64(tokens))
+		}
+		if tokens, ok := logMap["completion_tokens"].(float64); ok {
+			item.CompletionTokens = types.Int64Value(int64(tokens))
+		}
+		if tokens, ok := logMap["total_tokens"].(float64); ok {
+			item.TotalTokens = types.Int64Value(int64(tokens))
+		}
+
+		tags, _ := logMap["request_tags"].([]interface{})
+		item.RequestTags, _ = types.ListValueFrom(ctx, types.StringType, interfaceSliceToStrings(tags))
+
+		data.Logs = append(data.Logs, item)
+	}
+
+	data.TotalSpend = types.Float64Value(total)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
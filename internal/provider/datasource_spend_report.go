@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SpendReportDataSource{}
+
+func NewSpendReportDataSource() datasource.DataSource {
+	return &SpendReportDataSource{}
+}
+
+type SpendReportDataSource struct {
+	client *Client
+}
+
+type SpendReportDataSourceModel struct {
+	ID             types.String            `tfsdk:"id"`
+	StartDate      types.String            `tfsdk:"start_date"`
+	EndDate        types.String            `tfsdk:"end_date"`
+	GroupBy        types.String            `tfsdk:"group_by"`
+	APIKey         types.String            `tfsdk:"api_key"`
+	InternalUserID types.String            `tfsdk:"internal_user_id"`
+	TeamID         types.String            `tfsdk:"team_id"`
+	CustomerID     types.String            `tfsdk:"customer_id"`
+	TotalSpend     types.Float64           `tfsdk:"total_spend"`
+	Entries        []SpendReportEntryModel `tfsdk:"entries"`
+}
+
+type SpendReportEntryModel struct {
+	Date   types.String            `tfsdk:"date"`
+	Group  types.String            `tfsdk:"group"`
+	Spend  types.Float64           `tfsdk:"spend"`
+	Models []SpendReportModelModel `tfsdk:"models"`
+}
+
+type SpendReportModelModel struct {
+	Model       types.String  `tfsdk:"model"`
+	Spend       types.Float64 `tfsdk:"spend"`
+	TotalTokens types.Int64   `tfsdk:"total_tokens"`
+}
+
+// spendReportGroups maps each group_by value to the list the proxy returns the
+// groups in and the field naming each group.
+var spendReportGroups = map[string]struct {
+	listKey  string
+	nameKeys []string
+}{
+	"team":     {"teams", []string{"team_name", "team_id"}},
+	"customer": {"customers", []string{"customer", "end_user"}},
+	"api_key":  {"api_keys", []string{"api_key"}},
+}
+
+func (d *SpendReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_spend_report"
+}
+
+func (d *SpendReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves daily spend grouped by team, customer or API key, with a per-model breakdown. Requires an enterprise proxy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier.",
+				Computed:    true,
+			},
+			"start_date": schema.StringAttribute{
+				Description: "First day to report on, in YYYY-MM-DD format.",
+				Required:    true,
+				Validators:  isoDateValidators(),
+			},
+			"end_date": schema.StringAttribute{
+				Description: "Last day to report on, in YYYY-MM-DD format.",
+				Required:    true,
+				Validators:  isoDateValidators(),
+			},
+			"group_by": schema.StringAttribute{
+				Description: "How spend is grouped. Valid values: team, customer, api_key. Defaults to team.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("team", "customer", "api_key"),
+				},
+			},
+			"api_key": schema.StringAttribute{
+				Description: "Only report spend for this API key.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"internal_user_id": schema.StringAttribute{
+				Description: "Only report spend for this internal user.",
+				Optional:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Description: "Only report spend for this team.",
+				Optional:    true,
+			},
+			"customer_id": schema.StringAttribute{
+				Description: "Only report spend for this customer.",
+				Optional:    true,
+			},
+			"total_spend": schema.Float64Attribute{
+				Description: "Total spend in USD across all entries.",
+				Computed:    true,
+			},
+			"entries": schema.ListNestedAttribute{
+				Description: "Spend per day and group.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"date": schema.StringAttribute{
+							Description: "Day the spend was incurred on.",
+							Computed:    true,
+						},
+						"group": schema.StringAttribute{
+							Description: "Team name, customer or API key the spend belongs to.",
+							Computed:    true,
+						},
+						"spend": schema.Float64Attribute{
+							Description: "Spend in USD.",
+							Computed:    true,
+						},
+						"models": schema.ListNestedAttribute{
+							Description: "Spend per model.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"model": schema.StringAttribute{
+										Description: "Model name.",
+										Computed:    true,
+									},
+									"spend": schema.Float64Attribute{
+										Description: "Spend in USD.",
+										Computed:    true,
+									},
+									"total_tokens": schema.Int64Attribute{
+										Description: "Total tokens.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SpendReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SpendReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SpendReportDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupBy := "team"
+	if !data.GroupBy.IsNull() && data.GroupBy.ValueString() != "" {
+		groupBy = data.GroupBy.ValueString()
+	}
+
+	params := url.Values{}
+	params.Set("start_date", data.StartDate.ValueString())
+	params.Set("end_date", data.EndDate.ValueString())
+	params.Set("group_by", groupBy)
+	optional := map[string]types.String{
+		"api_key":          data.APIKey,
+		"internal_user_id": data.InternalUserID,
+		"team_id":          data.TeamID,
+		"customer_id":      data.CustomerID,
+	}
+	for param, value := range optional {
+		if !value.IsNull() && value.ValueString() != "" {
+			params.Set(param, value.ValueString())
+		}
+	}
+
+	var result []interface{}
+	if err := d.client.DoRequestWithResponse(ctx, "GET", "/global/spend/report?"+params.Encode(), nil, &result); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read spend report: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("spend_report")
+
+	group := spendReportGroups[groupBy]
+	total := 0.0
+	data.Entries = []SpendReportEntryModel{}
+
+	for _, r := range result {
+		day, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		date, _ := day["group_by_day"].(string)
+		if len(date) > 10 {
+			date = date[:10]
+		}
+
+		groups, _ := day[group.listKey].([]interface{})
+		for _, g := range groups {
+			groupMap, ok := g.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			entry := SpendReportEntryModel{
+				Date:   types.StringValue(date),
+				Group:  types.StringNull(),
+				Spend:  types.Float64Value(0),
+				Models: []SpendReportModelModel{},
+			}
+			for _, key := range group.nameKeys {
+				if name, ok := groupMap[key].(string); ok {
+					entry.Group = types.StringValue(name)
+					break
+				}
+			}
+			if spend, ok := groupMap["total_spend"].(float64); ok {
+				entry.Spend = types.Float64Value(spend)
+				total += spend
+			}
+
+			models, _ := groupMap["metadata"].([]interface{})
+			for _, m := range models {
+				modelMap, ok := m.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				model := SpendReportModelModel{
+					Model:       types.StringNull(),
+					Spend:       types.Float64Value(0),
+					TotalTokens: types.Int64Value(0),
+				}
+				if name, ok := modelMap["model"].(string); ok {
+					model.Model = types.StringValue(name)
+				}
+				if spend, ok := modelMap["spend"].(float64); ok {
+					model.Spend = types.Float64Value(spend)
+				}
+				if tokens, ok := modelMap["total_tokens"].(float64); ok {
+					model.TotalTokens = types.Int64Value(int64(tokens))
+				}
+				entry.Models = append(entry.Models, model)
+			}
+
+			data.Entries = append(data.Entries, entry)
+		}
+	}
+
+	data.TotalSpend = types.Float64Value(total)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &TagUsageDataSource{}
+
+func NewTagUsageDataSource() datasource.DataSource {
+	return &TagUsageDataSource{}
+}
+
+type TagUsageDataSource struct {
+	client *Client
+}
+
+type TagUsageDataSourceModel struct {
+	ID         types.String        `tfsdk:"id"`
+	StartDate  types.String        `tfsdk:"start_date"`
+	EndDate    types.String        `tfsdk:"end_date"`
+	TotalSpend types.Float64       `tfsdk:"total_spend"`
+	Tags       []TagUsageItemModel `tfsdk:"tags"`
+}
+
+type TagUsageItemModel struct {
+	Tag          types.String  `tfsdk:"tag"`
+	Spend        types.Float64 `tfsdk:"spend"`
+	RequestCount types.Int64   `tfsdk:"request_count"`
+}
+
+func (d *TagUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag_usage"
+}
+
+func (d *TagUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves total spend and request count per request tag. Requires an enterprise proxy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier.",
+				Computed:    true,
+			},
+			"start_date": schema.StringAttribute{
+				Description: "First day to report on, in YYYY-MM-DD format. Defaults to all time.",
+				Optional:    true,
+				Validators:  isoDateValidators(),
+			},
+			"end_date": schema.StringAttribute{
+				Description: "Last day to report on, in YYYY-MM-DD format. Defaults to all time.",
+				Optional:    true,
+				Validators:  isoDateValidators(),
+			},
+			"total_spend": schema.Float64Attribute{
+				Description: "Total spend in USD across all tags.",
+				Computed:    true,
+			},
+			"tags": schema.ListNestedAttribute{
+				Description: "Usage per tag.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tag": schema.StringAttribute{
+							Description: "Tag name.",
+							Computed:    true,
+						},
+						"spend": schema.Float64Attribute{
+							Description: "Spend in USD of requests with the tag.",
+							Computed:    true,
+						},
+						"request_count": schema.Int64Attribute{
+							Description: "Number of requests with the tag.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TagUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TagUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TagUsageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := "/spend/tags"
+	params := url.Values{}
+	if !data.StartDate.IsNull() && data.StartDate.ValueString() != "" {
+		params.Set("start_date", data.StartDate.ValueString())
+	}
+	if !data.EndDate.IsNull() && data.EndDate.ValueString() != "" {
+		params.Set("end_date", data.EndDate.ValueString())
+	}
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	var result []interface{}
+	if err := d.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tag usage: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("tag_usage")
+
+	total := 0.0
+	data.Tags = make([]TagUsageItemModel, 0, len(result))
+	for _, r := range result {
+		tagMap, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		item := TagUsageItemModel{
+			Tag:          types.StringNull(),
+			Spend:        types.Float64Value(0),
+			RequestCount: types.Int64Value(0),
+		}
+		if tag, ok := tagMap["individual_request_tag"].(string); ok {
+			item.Tag = types.StringValue(tag)
+		}
+		if spend, ok := tagMap["total_spend"].(float64); ok {
+			item.Spend = types.Float64Value(spend)
+			total += spend
+		}
+		if count, ok := tagMap["log_count"].(float64); ok {
+			item.RequestCount = types.Int64Value(int64(count))
+		}
+
+		data.Tags = append(data.Tags, item)
+	}
+
+	data.TotalSpend = types.Float64Value(total)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -22,8 +22,10 @@ type TagsListDataSource struct {
 }
 
 type TagsListDataSourceModel struct {
-	ID   types.String       `tfsdk:"id"`
-	Tags []TagListItemModel `tfsdk:"tags"`
+	ID       types.String       `tfsdk:"id"`
+	MaxItems types.Int64        `tfsdk:"max_items"`
+	Filter   *ListFilterModel   `tfsdk:"filter"`
+	Tags     []TagListItemModel `tfsdk:"tags"`
 }
 
 type TagListItemModel struct {
@@ -52,6 +54,10 @@ func (d *TagsListDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				Description: "Placeholder identifier for this data source.",
 				Computed:    true,
 			},
+			"max_items": schema.Int64Attribute{
+				Description: "Maximum number of tags to return. All tags are returned when unset.",
+				Optional:    true,
+			},
 			"tags": schema.ListNestedAttribute{
 				Description: "List of tags.",
 				Computed:    true,
@@ -106,6 +112,9 @@ func (d *TagsListDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock(),
+		},
 	}
 }
 
@@ -134,14 +143,33 @@ func (d *TagsListDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	var results []map[string]interface{}
-	if err := d.client.DoRequestWithResponse(ctx, "GET", "/tag/list", nil, &results); err != nil {
+	filter, diags := newListFilter(ctx, data.Filter, listFilterFields{
+		Name:      "name",
+		Spend:     "spend",
+		Budget:    "max_budget",
+		CreatedAt: "created_at",
+	}, "litellm_tags")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, err := d.client.ListAll(ctx, "/tag/list", ListOptions{
+		ItemsKeys: []string{"tags", "data"},
+		MaxItems:  int(data.MaxItems.ValueInt64()),
+		Filter:    filter.Match,
+	})
+	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list tags: %s", err))
 		return
 	}
 
 	tags := make([]TagListItemModel, 0, len(results))
-	for _, result := range results {
+	for _, r := range results {
+		result, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
 		tag := TagListItemModel{}
 
 		if name, ok := result["name"].(string); ok {
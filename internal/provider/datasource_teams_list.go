@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -31,9 +32,11 @@ type TeamListItem struct {
 }
 
 type TeamsListDataSourceModel struct {
-	ID             types.String   `tfsdk:"id"`
-	OrganizationID types.String   `tfsdk:"organization_id"`
-	Teams          []TeamListItem `tfsdk:"teams"`
+	ID             types.String     `tfsdk:"id"`
+	OrganizationID types.String     `tfsdk:"organization_id"`
+	MaxItems       types.Int64      `tfsdk:"max_items"`
+	Filter         *ListFilterModel `tfsdk:"filter"`
+	Teams          []TeamListItem   `tfsdk:"teams"`
 }
 
 func (d *TeamsListDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -52,6 +55,10 @@ func (d *TeamsListDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				Description: "Optional organization ID to filter teams.",
 				Optional:    true,
 			},
+			"max_items": schema.Int64Attribute{
+				Description: "Maximum number of teams to return. All teams are returned when unset.",
+				Optional:    true,
+			},
 			"teams": schema.ListNestedAttribute{
 				Description: "List of teams.",
 				Computed:    true,
@@ -93,6 +100,9 @@ func (d *TeamsListDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock(),
+		},
 	}
 }
 
@@ -121,13 +131,43 @@ func (d *TeamsListDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	endpoint := "/team/list"
+	filter, diags := newListFilter(ctx, data.Filter, listFilterFields{
+		Name:      "team_id",
+		Alias:     "team_alias",
+		Metadata:  "metadata",
+		Blocked:   "blocked",
+		Spend:     "spend",
+		Budget:    "max_budget",
+		CreatedAt: "created_at",
+	}, "litellm_teams")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := url.Values{}
 	if !data.OrganizationID.IsNull() && data.OrganizationID.ValueString() != "" {
-		endpoint = fmt.Sprintf("/team/list?organization_id=%s", data.OrganizationID.ValueString())
+		params.Set("organization_id", data.OrganizationID.ValueString())
+	}
+	// team_alias is a partial match, so any literal pattern can be pushed down.
+	if alias, _, ok := filter.aliasLiteral(); ok {
+		params.Set("team_alias", alias)
+	}
+
+	// /v2/team/list is the paginated variant of /team/list.
+	endpoint := "/v2/team/list"
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
 	}
 
-	var result map[string]interface{}
-	if err := d.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
+	teamsData, err := d.client.ListAll(ctx, endpoint, ListOptions{
+		ItemsKeys: []string{"teams", "data"},
+		PageParam: "page",
+		SizeParam: "page_size",
+		MaxItems:  int(data.MaxItems.ValueInt64()),
+		Filter:    filter.Match,
+	})
+	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list teams: %s", err))
 		return
 	}
@@ -135,14 +175,6 @@ func (d *TeamsListDataSource) Read(ctx context.Context, req datasource.ReadReque
 	// Set placeholder ID
 	data.ID = types.StringValue("teams")
 
-	// Parse the response
-	var teamsData []interface{}
-	if teams, ok := result["teams"].([]interface{}); ok {
-		teamsData = teams
-	} else if dataArr, ok := result["data"].([]interface{}); ok {
-		teamsData = dataArr
-	}
-
 	data.Teams = make([]TeamListItem, 0, len(teamsData))
 	for _, t := range teamsData {
 		teamMap, ok := t.(map[string]interface{})
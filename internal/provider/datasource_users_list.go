@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -31,9 +32,11 @@ type UserListItem struct {
 }
 
 type UsersListDataSourceModel struct {
-	ID       types.String   `tfsdk:"id"`
-	UserRole types.String   `tfsdk:"user_role"`
-	Users    []UserListItem `tfsdk:"users"`
+	ID       types.String     `tfsdk:"id"`
+	UserRole types.String     `tfsdk:"user_role"`
+	MaxItems types.Int64      `tfsdk:"max_items"`
+	Filter   *ListFilterModel `tfsdk:"filter"`
+	Users    []UserListItem   `tfsdk:"users"`
 }
 
 func (d *UsersListDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -49,7 +52,11 @@ func (d *UsersListDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				Computed:    true,
 			},
 			"user_role": schema.StringAttribute{
-				Description: "Optional user role to filter by.",
+				Description: "Optional user role to filter by, sent to the proxy as the role query parameter.",
+				Optional:    true,
+			},
+			"max_items": schema.Int64Attribute{
+				Description: "Maximum number of users to return. All users are returned when unset.",
 				Optional:    true,
 			},
 			"users": schema.ListNestedAttribute{
@@ -93,6 +100,9 @@ func (d *UsersListDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock(),
+		},
 	}
 }
 
@@ -121,13 +131,41 @@ func (d *UsersListDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	endpoint := "/user/list"
+	filter, diags := newListFilter(ctx, data.Filter, listFilterFields{
+		Name:      "user_email",
+		Alias:     "user_alias",
+		Metadata:  "metadata",
+		Spend:     "spend",
+		Budget:    "max_budget",
+		CreatedAt: "created_at",
+	}, "litellm_users")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := url.Values{}
 	if !data.UserRole.IsNull() && data.UserRole.ValueString() != "" {
-		endpoint = fmt.Sprintf("/user/list?user_role=%s", data.UserRole.ValueString())
+		params.Set("role", data.UserRole.ValueString())
+	}
+	// user_email is a partial match, so any literal pattern can be pushed down.
+	if email, _, ok := filter.nameLiteral(); ok {
+		params.Set("user_email", email)
 	}
 
-	var result map[string]interface{}
-	if err := d.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
+	endpoint := "/user/list"
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	usersData, err := d.client.ListAll(ctx, endpoint, ListOptions{
+		ItemsKeys: []string{"users", "data"},
+		PageParam: "page",
+		SizeParam: "page_size",
+		MaxItems:  int(data.MaxItems.ValueInt64()),
+		Filter:    filter.Match,
+	})
+	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users: %s", err))
 		return
 	}
@@ -135,14 +173,6 @@ func (d *UsersListDataSource) Read(ctx context.Context, req datasource.ReadReque
 	// Set placeholder ID
 	data.ID = types.StringValue("users")
 
-	// Parse the response
-	var usersData []interface{}
-	if users, ok := result["users"].([]interface{}); ok {
-		usersData = users
-	} else if dataArr, ok := result["data"].([]interface{}); ok {
-		usersData = dataArr
-	}
-
 	data.Users = make([]UserListItem, 0, len(usersData))
 	for _, u := range usersData {
 		userMap, ok := u.(map[string]interface{})
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dynamicToInterface converts a dynamic value into the plain Go value it would
+// be sent to the API as. Null values become nil.
+func dynamicToInterface(value types.Dynamic) (interface{}, error) {
+	if value.IsNull() || value.IsUnknown() || value.IsUnderlyingValueNull() {
+		return nil, nil
+	}
+	if value.IsUnderlyingValueUnknown() {
+		return nil, fmt.Errorf("value is not known yet")
+	}
+	return attrValueToInterface(value.UnderlyingValue())
+}
+
+func attrValueToInterface(value attr.Value) (interface{}, error) {
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("value is not known yet")
+	}
+
+	switch v := value.(type) {
+	case types.String:
+		return v.ValueString(), nil
+	case types.Bool:
+		return v.ValueBool(), nil
+	case types.Int64:
+		return v.ValueInt64(), nil
+	case types.Float64:
+		return v.ValueFloat64(), nil
+	case types.Number:
+		f := v.ValueBigFloat()
+		if f.IsInt() {
+			if i, accuracy := f.Int64(); accuracy == big.Exact {
+				return i, nil
+			}
+		}
+		n, _ := f.Float64()
+		return n, nil
+	case types.Dynamic:
+		return dynamicToInterface(v)
+	case types.Object:
+		return attrMapToInterface(v.Attributes())
+	case types.Map:
+		return attrMapToInterface(v.Elements())
+	case types.List:
+		return attrSliceToInterface(v.Elements())
+	case types.Set:
+		return attrSliceToInterface(v.Elements())
+	case types.Tuple:
+		return attrSliceToInterface(v.Elements())
+	}
+
+	return nil, fmt.Errorf("unsupported value type %T", value)
+}
+
+func attrMapToInterface(values map[string]attr.Value) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		converted, err := attrValueToInterface(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		result[key] = converted
+	}
+	return result, nil
+}
+
+func attrSliceToInterface(values []attr.Value) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(values))
+	for i, value := range values {
+		converted, err := attrValueToInterface(value)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		result = append(result, converted)
+	}
+	return result, nil
+}
+
+// interfaceToAttrValue converts a decoded JSON value into the attr.Value that
+// Terraform would build for the same literal in configuration: objects for
+// maps, tuples for arrays.
+func interfaceToAttrValue(ctx context.Context, value interface{}) attr.Value {
+	switch v := value.(type) {
+	case nil:
+		return types.StringNull()
+	case string:
+		return types.StringValue(v)
+	case bool:
+		return types.BoolValue(v)
+	case float64:
+		return types.NumberValue(big.NewFloat(v))
+	case int64:
+		return types.NumberValue(new(big.Float).SetInt64(v))
+	case map[string]interface{}:
+		attrTypes := make(map[string]attr.Type, len(v))
+		attrs := make(map[string]attr.Value, len(v))
+		for key, item := range v {
+			attrs[key] = interfaceToAttrValue(ctx, item)
+			attrTypes[key] = attrs[key].Type(ctx)
+		}
+		return types.ObjectValueMust(attrTypes, attrs)
+	case []interface{}:
+		elemTypes := make([]attr.Type, 0, len(v))
+		elems := make([]attr.Value, 0, len(v))
+		for _, item := range v {
+			elem := interfaceToAttrValue(ctx, item)
+			elemTypes = append(elemTypes, elem.Type(ctx))
+			elems = append(elems, elem)
+		}
+		return types.TupleValueMust(elemTypes, elems)
+	}
+
+	return types.StringValue(fmt.Sprint(value))
+}
+
+// jsonEqual reports whether two values encode to the same JSON, so that e.g. a
+// configured int64 matches the float64 the API returns.
+func jsonEqual(a, b interface{}) bool {
+	return reflect.DeepEqual(normalizeJSON(a), normalizeJSON(b))
+}
+
+func normalizeJSON(value interface{}) interface{} {
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(jsonBytes, &normalized); err != nil {
+		return value
+	}
+	return normalized
+}
+
+// dynamicObjectElements returns the elements of a dynamic value holding an
+// object or map. ok is false for any other value, including null and unknown.
+func dynamicObjectElements(value types.Dynamic) (map[string]attr.Value, bool) {
+	if value.IsNull() || value.IsUnknown() || value.IsUnderlyingValueNull() || value.IsUnderlyingValueUnknown() {
+		return nil, false
+	}
+	switch v := value.UnderlyingValue().(type) {
+	case types.Object:
+		return v.Attributes(), true
+	case types.Map:
+		return v.Elements(), true
+	}
+	return nil, false
+}
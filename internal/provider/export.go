@@ -0,0 +1,498 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ExportOptions configures Export.
+type ExportOptions struct {
+	APIBase            string
+	APIKey             string
+	InsecureSkipVerify bool
+
+	// OutDir is the directory the configuration is written to. Existing
+	// files are never overwritten.
+	OutDir string
+}
+
+// ExportResult describes what Export wrote.
+type ExportResult struct {
+	// Files are the paths of the files written.
+	Files []string
+	// Counts is the number of objects exported per resource type.
+	Counts map[string]int
+	// Warnings describe objects that could not be listed or read and were
+	// left out.
+	Warnings []string
+}
+
+// exportKind describes how objects of one resource type are exported.
+type exportKind struct {
+	typeName    string
+	newResource func() resource.Resource
+	list        func(ctx context.Context, c *Client) ([]exportItem, error)
+
+	// idAttribute holds the object's import ID in state. References from
+	// other objects point at it.
+	idAttribute string
+	// references maps attributes that hold the ID of another object to the
+	// resource type of that object.
+	references map[string]string
+	// skip lists attributes managed through another exported resource.
+	skip []string
+}
+
+// exportItem is an object found on the proxy.
+type exportItem struct {
+	id   string
+	name string
+	// values fill string attributes the resource cannot read back from the
+	// proxy.
+	values map[string]string
+}
+
+// exportedObject is an item read through its resource.
+type exportedObject struct {
+	item  exportItem
+	label string
+	state tftypes.Value
+}
+
+// exportKinds are the exported resource types, in the order their files list
+// them.
+var exportKinds = []exportKind{
+	{
+		typeName:    "litellm_credential",
+		newResource: NewCredentialResource,
+		list:        exportList("/credentials", ListOptions{ItemsKeys: []string{"credentials", "data"}}, "credential_name"),
+		idAttribute: "credential_name",
+	},
+	{
+		typeName:    "litellm_budget",
+		newResource: NewBudgetResource,
+		list:        exportList("/budget/list", ListOptions{ItemsKeys: []string{"budgets", "data"}}, "budget_id"),
+		idAttribute: "budget_id",
+	},
+	{
+		typeName:    "litellm_organization",
+		newResource: NewOrganizationResource,
+		list:        exportList("/organization/list", ListOptions{ItemsKeys: []string{"organizations", "data"}}, "organization_id", "organization_alias"),
+		idAttribute: "organization_id",
+		references:  map[string]string{"budget_id": "litellm_budget"},
+	},
+	{
+		typeName:    "litellm_team",
+		newResource: NewTeamResource,
+		list:        exportList("/v2/team/list", exportTeamListOptions, "team_id", "team_alias"),
+		idAttribute: "id",
+		references:  map[string]string{"organization_id": "litellm_organization"},
+	},
+	{
+		typeName:    "litellm_user",
+		newResource: NewUserResource,
+		list:        exportList("/user/list", ListOptions{ItemsKeys: []string{"users", "data"}, PageParam: "page", SizeParam: "page_size"}, "user_id", "user_email", "user_alias"),
+		idAttribute: "user_id",
+		// Team memberships are exported as litellm_team_member.
+		skip: []string{"teams"},
+	},
+	{
+		typeName:    "litellm_organization_member",
+		newResource: NewOrganizationMemberResource,
+		list:        exportOrganizationMembers,
+		idAttribute: "id",
+		references:  map[string]string{"organization_id": "litellm_organization", "user_id": "litellm_user"},
+	},
+	{
+		typeName:    "litellm_team_member",
+		newResource: NewTeamMemberResource,
+		list:        exportTeamMembers,
+		idAttribute: "id",
+		references:  map[string]string{"team_id": "litellm_team", "user_id": "litellm_user"},
+	},
+	{
+		typeName:    "litellm_model",
+		newResource: NewModelResource,
+		list:        exportList("/model/info", ListOptions{ItemsKeys: []string{"data", "models"}}, "model_info.id", "model_name"),
+		idAttribute: "id",
+		references:  map[string]string{"litellm_credential_name": "litellm_credential", "team_id": "litellm_team"},
+	},
+	{
+		typeName:    "litellm_key",
+		newResource: NewKeyResource,
+		list:        exportList("/key/list?return_full_object=true", ListOptions{ItemsKeys: []string{"keys", "data"}, PageParam: "page", SizeParam: "size"}, "token", "key_alias", "key_name"),
+		idAttribute: "id",
+		references: map[string]string{
+			"team_id":         "litellm_team",
+			"user_id":         "litellm_user",
+			"organization_id": "litellm_organization",
+			"budget_id":       "litellm_budget",
+		},
+	},
+	{
+		typeName:    "litellm_tag",
+		newResource: NewTagResource,
+		list:        exportList("/tag/list", ListOptions{ItemsKeys: []string{"tags", "data"}}, "name"),
+		idAttribute: "name",
+		references:  map[string]string{"budget_id": "litellm_budget"},
+	},
+	{
+		typeName:    "litellm_guardrail",
+		newResource: NewGuardrailResource,
+		list:        exportList("/guardrails/list", ListOptions{ItemsKeys: []string{"guardrails", "data"}}, "guardrail_id", "guardrail_name"),
+		idAttribute: "guardrail_id",
+	},
+	{
+		typeName:    "litellm_mcp_server",
+		newResource: NewMCPServerResource,
+		list:        exportList("/v1/mcp/server", ListOptions{ItemsKeys: []string{"data", "servers"}}, "server_id", "server_name", "alias"),
+		idAttribute: "server_id",
+	},
+	{
+		typeName:    "litellm_prompt",
+		newResource: NewPromptResource,
+		list:        exportList("/prompts/list", ListOptions{ItemsKeys: []string{"prompts", "data"}}, "prompt_id"),
+		idAttribute: "prompt_id",
+	},
+	{
+		typeName:    "litellm_search_tool",
+		newResource: NewSearchToolResource,
+		list:        exportList("/search_tools/list", ListOptions{ItemsKeys: []string{"search_tools", "data"}}, "search_tool_id", "search_tool_name"),
+		idAttribute: "search_tool_id",
+	},
+	{
+		typeName:    "litellm_vector_store",
+		newResource: NewVectorStoreResource,
+		list:        exportList("/vector_store/list", ListOptions{ItemsKeys: []string{"data", "vector_stores"}}, "vector_store_id", "vector_store_name"),
+		idAttribute: "vector_store_id",
+		references:  map[string]string{"litellm_credential_name": "litellm_credential"},
+	},
+}
+
+var exportTeamListOptions = ListOptions{ItemsKeys: []string{"teams", "data"}, PageParam: "page", SizeParam: "page_size"}
+
+// exportList lists the objects of a list endpoint, named after the first
+// non-empty display field.
+func exportList(endpoint string, opts ListOptions, idField string, displayFields ...string) func(ctx context.Context, c *Client) ([]exportItem, error) {
+	return func(ctx context.Context, c *Client) ([]exportItem, error) {
+		items, err := c.ListAll(ctx, endpoint, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		var exportItems []exportItem
+		for _, object := range listedObjects(items, idField, displayFields...) {
+			exportItems = append(exportItems, exportItem{id: object.id, name: object.displayName})
+		}
+		return exportItems, nil
+	}
+}
+
+// exportTeamMembers lists the members of every team. The proxy has no
+// endpoint to read a single member, so their role comes from the team.
+func exportTeamMembers(ctx context.Context, c *Client) ([]exportItem, error) {
+	teams, err := c.ListAll(ctx, "/v2/team/list", exportTeamListOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []exportItem
+	for _, team := range listedObjectMaps(teams) {
+		teamID, _ := team["team_id"].(string)
+		if teamID == "" {
+			continue
+		}
+		teamName := teamID
+		if alias, ok := team["team_alias"].(string); ok && alias != "" {
+			teamName = alias
+		}
+
+		members, _ := team["members_with_roles"].([]interface{})
+		for _, member := range listedObjectMaps(members) {
+			userID, _ := member["user_id"].(string)
+			if userID == "" {
+				continue
+			}
+
+			item := exportItem{id: teamID + ":" + userID, name: teamName + "_" + userID, values: map[string]string{}}
+			if role, ok := member["role"].(string); ok {
+				item.values["role"] = role
+			}
+			if email, ok := member["user_email"].(string); ok && email != "" {
+				item.values["user_email"] = email
+				item.name = teamName + "_" + email
+			}
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// exportOrganizationMembers lists the members of every organization.
+func exportOrganizationMembers(ctx context.Context, c *Client) ([]exportItem, error) {
+	organizations, err := c.ListAll(ctx, "/organization/list", ListOptions{ItemsKeys: []string{"organizations", "data"}})
+	if err != nil {
+		return nil, err
+	}
+
+	var items []exportItem
+	for _, organization := range listedObjectMaps(organizations) {
+		organizationID, _ := organization["organization_id"].(string)
+		if organizationID == "" {
+			continue
+		}
+		organizationName := organizationID
+		if alias, ok := organization["organization_alias"].(string); ok && alias != "" {
+			organizationName = alias
+		}
+
+		members, _ := organization["members"].([]interface{})
+		for _, member := range listedObjectMaps(members) {
+			userID, _ := member["user_id"].(string)
+			if userID == "" {
+				continue
+			}
+
+			item := exportItem{id: organizationID + ":" + userID, name: organizationName + "_" + userID, values: map[string]string{}}
+			if role, ok := member["user_role"].(string); ok {
+				item.values["role"] = role
+			} else if role, ok := member["role"].(string); ok {
+				item.values["role"] = role
+			}
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func listedObjectMaps(items []interface{}) []map[string]interface{} {
+	maps := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if itemMap, ok := item.(map[string]interface{}); ok {
+			maps = append(maps, itemMap)
+		}
+	}
+	return maps
+}
+
+// Export reads every supported object of a proxy through the provider's
+// resources and writes them as Terraform configuration with import blocks,
+// one file per resource type. IDs of other exported objects are written as
+// references to them, and required secrets as variables.
+func Export(ctx context.Context, opts ExportOptions) (*ExportResult, error) {
+	client := &Client{
+		APIBase:    opts.APIBase,
+		APIKey:     opts.APIKey,
+		HTTPClient: newHTTPClient(opts.InsecureSkipVerify),
+	}
+
+	result := &ExportResult{Counts: map[string]int{}}
+	schemas := map[string]resource.SchemaResponse{}
+	objects := map[string][]exportedObject{}
+	// labels maps resource type and import ID to the exported resource name.
+	labels := map[string]map[string]string{}
+
+	for _, kind := range exportKinds {
+		items, err := kind.list(ctx, client)
+		if err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("Unable to list %s: %s", kind.typeName, err))
+			continue
+		}
+
+		r := kind.newResource()
+		if rc, ok := r.(resource.ResourceWithConfigure); ok {
+			rc.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &resource.ConfigureResponse{})
+		}
+		var schemaResp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		schemas[kind.typeName] = schemaResp
+		labels[kind.typeName] = map[string]string{}
+
+		used := map[string]bool{}
+		for _, item := range items {
+			state, err := exportRead(ctx, r.(resource.ResourceWithImportState), schemaResp, item)
+			if err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("Unable to read %s %s: %s", kind.typeName, item.id, err))
+				continue
+			}
+
+			label := exportLabel(kind.typeName, item.name, used)
+			labels[kind.typeName][item.id] = label
+			objects[kind.typeName] = append(objects[kind.typeName], exportedObject{item: item, label: label, state: state})
+		}
+		result.Counts[kind.typeName] = len(objects[kind.typeName])
+	}
+
+	files := map[string]string{}
+	var variables strings.Builder
+	for _, kind := range exportKinds {
+		if len(objects[kind.typeName]) == 0 {
+			continue
+		}
+
+		var sb strings.Builder
+		for i, object := range objects[kind.typeName] {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			fmt.Fprintf(&sb, "import {\n  to = %s.%s\n  id = %s\n}\n\n", kind.typeName, object.label, hclString(object.item.id))
+			fmt.Fprintf(&sb, "resource %q %q {\n", kind.typeName, object.label)
+
+			writer := &hclBlockWriter{
+				reference: func(attribute, value string) (string, bool) {
+					refType, ok := kind.references[attribute]
+					if !ok {
+						return "", false
+					}
+					refLabel, ok := labels[refType][value]
+					if !ok {
+						return "", false
+					}
+					return fmt.Sprintf("%s.%s.%s", refType, refLabel, exportIDAttribute(refType)), true
+				},
+				variable: func(attribute string, typ tftypes.Type) string {
+					name := object.label + "_" + attribute
+					if variables.Len() > 0 {
+						variables.WriteString("\n")
+					}
+					fmt.Fprintf(&variables, "variable %q {\n", name)
+					writeHCLAttributes(&variables, "  ", []hclAttribute{
+						{"description", hclString(fmt.Sprintf("%s of %s.%s", attribute, kind.typeName, object.label))},
+						{"type", hclType(typ)},
+						{"sensitive", "true"},
+					})
+					variables.WriteString("}\n")
+					return name
+				},
+			}
+
+			attributes := schemas[kind.typeName].Schema.Attributes
+			for _, name := range kind.skip {
+				attributes = withoutAttribute(attributes, name)
+			}
+			writer.writeBody(&sb, "  ", "", attributes, schemas[kind.typeName].Schema.Blocks, object.state)
+			sb.WriteString("}\n")
+		}
+		files[kind.typeName+".tf"] = sb.String()
+	}
+
+	if variables.Len() > 0 {
+		files["variables.tf"] = variables.String()
+	}
+	files["provider.tf"] = exportProviderConfig(opts.APIBase)
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if err := os.MkdirAll(opts.OutDir, 0o755); err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if _, err := os.Stat(filepath.Join(opts.OutDir, name)); err == nil {
+			return nil, fmt.Errorf("%s already exists", filepath.Join(opts.OutDir, name))
+		}
+	}
+	for _, name := range names {
+		filename := filepath.Join(opts.OutDir, name)
+		if err := os.WriteFile(filename, []byte(files[name]), 0o644); err != nil {
+			return nil, err
+		}
+		result.Files = append(result.Files, filename)
+	}
+
+	return result, nil
+}
+
+// exportRead imports and reads an object the same way terraform import does.
+func exportRead(ctx context.Context, r resource.ResourceWithImportState, schemaResp resource.SchemaResponse, item exportItem) (tftypes.Value, error) {
+	res := &tfsdk.Resource{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := readListedResource(ctx, r, item.id, res, nil)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: res.Raw}
+	for _, name := range sortedKeys(item.values) {
+		var current types.String
+		if !diags.HasError() && !state.GetAttribute(ctx, path.Root(name), &current).HasError() && current.IsNull() {
+			diags.Append(state.SetAttribute(ctx, path.Root(name), item.values[name])...)
+		}
+	}
+
+	if diags.HasError() {
+		var messages []string
+		for _, d := range diags.Errors() {
+			messages = append(messages, d.Summary()+": "+d.Detail())
+		}
+		return tftypes.Value{}, fmt.Errorf("%s", strings.Join(messages, "; "))
+	}
+	return state.Raw, nil
+}
+
+func exportIDAttribute(typeName string) string {
+	for _, kind := range exportKinds {
+		if kind.typeName == typeName {
+			return kind.idAttribute
+		}
+	}
+	return "id"
+}
+
+var exportLabelPattern = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// exportLabel turns a display name into a unique resource name.
+func exportLabel(typeName, name string, used map[string]bool) string {
+	label := strings.Trim(exportLabelPattern.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = strings.TrimSuffix(strings.TrimPrefix(typeName, "litellm_")+"_"+label, "_")
+	}
+
+	unique := label
+	for i := 2; used[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	used[unique] = true
+	return unique
+}
+
+func withoutAttribute(attributes map[string]schema.Attribute, name string) map[string]schema.Attribute {
+	filtered := make(map[string]schema.Attribute, len(attributes))
+	for key, value := range attributes {
+		if key != name {
+			filtered[key] = value
+		}
+	}
+	return filtered
+}
+
+// exportProviderConfig configures the provider for the exported proxy. The
+// API key is left to the LITELLM_API_KEY environment variable.
+func exportProviderConfig(apiBase string) string {
+	return fmt.Sprintf(`terraform {
+  required_providers {
+    litellm = {
+      source = "registry.terraform.io/ncecere/litellm"
+    }
+  }
+}
+
+provider "litellm" {
+  api_base = %s
+}
+`, hclString(apiBase))
+}
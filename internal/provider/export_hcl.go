@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// hclAttribute is an attribute assignment; multi-line values are already
+// indented for the body they are written to.
+type hclAttribute struct {
+	name  string
+	value string
+}
+
+// writeHCLAttributes writes attribute assignments, aligning the equals signs
+// of consecutive attributes the way terraform fmt does.
+func writeHCLAttributes(sb *strings.Builder, indent string, attrs []hclAttribute) {
+	for start := 0; start < len(attrs); {
+		end, width := start, 0
+		for end < len(attrs) {
+			if len(attrs[end].name) > width {
+				width = len(attrs[end].name)
+			}
+			end++
+			// A multi-line value ends the aligned run.
+			if strings.Contains(attrs[end-1].value, "\n") {
+				break
+			}
+		}
+		for _, attr := range attrs[start:end] {
+			fmt.Fprintf(sb, "%s%-*s = %s\n", indent, width, attr.name, attr.value)
+		}
+		start = end
+	}
+}
+
+// hclBlockWriter renders resource state as the body of a resource block.
+type hclBlockWriter struct {
+	// reference returns the expression to write instead of a top-level
+	// string value, if the value is the ID of another exported object.
+	reference func(attribute, value string) (string, bool)
+	// variable declares a variable for a sensitive attribute and returns its name.
+	variable func(attribute string, typ tftypes.Type) string
+}
+
+// writeBody writes the configurable attributes and blocks of an object.
+// Computed-only attributes and null values are left out. Sensitive and
+// write-only attributes are never written; required ones refer to a variable
+// instead. prefix is the block path, used to name those variables.
+func (w *hclBlockWriter) writeBody(sb *strings.Builder, indent, prefix string, attributes map[string]schema.Attribute, blocks map[string]schema.Block, value tftypes.Value) {
+	values := map[string]tftypes.Value{}
+	if err := value.As(&values); err != nil {
+		return
+	}
+
+	var attrs []hclAttribute
+	for _, name := range sortedKeys(attributes) {
+		attribute := attributes[name]
+		if attribute.IsComputed() && !attribute.IsOptional() && !attribute.IsRequired() {
+			continue
+		}
+
+		v, ok := values[name]
+		if attribute.IsSensitive() || attribute.IsWriteOnly() {
+			if attribute.IsRequired() && ok {
+				attrs = append(attrs, hclAttribute{name, "var." + w.variable(prefix+name, v.Type())})
+			}
+			continue
+		}
+		if !ok || v.IsNull() || !v.IsKnown() {
+			continue
+		}
+
+		if prefix == "" && v.Type().Is(tftypes.String) {
+			var s string
+			if err := v.As(&s); err == nil {
+				if expr, ok := w.reference(name, s); ok {
+					attrs = append(attrs, hclAttribute{name, expr})
+					continue
+				}
+			}
+		}
+		attrs = append(attrs, hclAttribute{name, hclValue(v, indent)})
+	}
+	writeHCLAttributes(sb, indent, attrs)
+
+	for _, name := range sortedKeys(blocks) {
+		v, ok := values[name]
+		if !ok || v.IsNull() || !v.IsKnown() {
+			continue
+		}
+
+		var nested []tftypes.Value
+		var nestedAttributes map[string]schema.Attribute
+		var nestedBlocks map[string]schema.Block
+		switch block := blocks[name].(type) {
+		case schema.SingleNestedBlock:
+			nested = []tftypes.Value{v}
+			nestedAttributes, nestedBlocks = block.Attributes, block.Blocks
+		case schema.ListNestedBlock:
+			if err := v.As(&nested); err != nil {
+				continue
+			}
+			nestedAttributes, nestedBlocks = block.NestedObject.Attributes, block.NestedObject.Blocks
+		case schema.SetNestedBlock:
+			if err := v.As(&nested); err != nil {
+				continue
+			}
+			nestedAttributes, nestedBlocks = block.NestedObject.Attributes, block.NestedObject.Blocks
+		default:
+			continue
+		}
+
+		for _, element := range nested {
+			fmt.Fprintf(sb, "\n%s%s {\n", indent, name)
+			w.writeBody(sb, indent+"  ", prefix+name+"_", nestedAttributes, nestedBlocks, element)
+			fmt.Fprintf(sb, "%s}\n", indent)
+		}
+	}
+}
+
+// hclValue renders a value as an HCL expression. Nested lines are indented
+// one level deeper than indent.
+func hclValue(v tftypes.Value, indent string) string {
+	if v.IsNull() || !v.IsKnown() {
+		return "null"
+	}
+
+	typ := v.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		_ = v.As(&s)
+		return hclString(s)
+	case typ.Is(tftypes.Number):
+		var f big.Float
+		_ = v.As(&f)
+		if f.IsInt() {
+			return f.Text('f', 0)
+		}
+		f64, _ := f.Float64()
+		return strconv.FormatFloat(f64, 'f', -1, 64)
+	case typ.Is(tftypes.Bool):
+		var b bool
+		_ = v.As(&b)
+		return strconv.FormatBool(b)
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		_ = v.As(&elements)
+		if len(elements) == 0 {
+			return "[]"
+		}
+
+		rendered := make([]string, len(elements))
+		multiline := false
+		for i, element := range elements {
+			rendered[i] = hclValue(element, indent+"  ")
+			multiline = multiline || strings.Contains(rendered[i], "\n")
+		}
+		if !multiline {
+			return "[" + strings.Join(rendered, ", ") + "]"
+		}
+
+		var sb strings.Builder
+		sb.WriteString("[\n")
+		for _, element := range rendered {
+			fmt.Fprintf(&sb, "%s  %s,\n", indent, element)
+		}
+		sb.WriteString(indent + "]")
+		return sb.String()
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var entries map[string]tftypes.Value
+		_ = v.As(&entries)
+		var attrs []hclAttribute
+		for _, key := range sortedKeys(entries) {
+			if typ.Is(tftypes.Object{}) && entries[key].IsNull() {
+				continue
+			}
+			attrs = append(attrs, hclAttribute{hclObjectKey(key), hclValue(entries[key], indent+"  ")})
+		}
+		if len(attrs) == 0 {
+			return "{}"
+		}
+
+		var sb strings.Builder
+		sb.WriteString("{\n")
+		writeHCLAttributes(&sb, indent+"  ", attrs)
+		sb.WriteString(indent + "}")
+		return sb.String()
+	}
+
+	return "null"
+}
+
+// hclString quotes a string, escaping template sequences so the value is
+// taken literally.
+func hclString(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	runes := []rune(s)
+	for i, r := range runes {
+		switch r {
+		case '\\':
+			sb.WriteString(`\\`)
+		case '"':
+			sb.WriteString(`\"`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '$', '%':
+			sb.WriteRune(r)
+			if i+1 < len(runes) && runes[i+1] == '{' {
+				sb.WriteRune(r)
+			}
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(&sb, `\u%04x`, r)
+			} else {
+				sb.WriteRune(r)
+			}
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+var hclIdentifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// hclObjectKey returns key as an object key, quoted unless it is an identifier.
+func hclObjectKey(key string) string {
+	if hclIdentifierPattern.MatchString(key) {
+		return key
+	}
+	return hclString(key)
+}
+
+// hclType renders a type constraint for a variable declaration.
+func hclType(typ tftypes.Type) string {
+	switch t := typ.(type) {
+	case tftypes.List:
+		return "list(" + hclType(t.ElementType) + ")"
+	case tftypes.Set:
+		return "set(" + hclType(t.ElementType) + ")"
+	case tftypes.Map:
+		return "map(" + hclType(t.ElementType) + ")"
+	case tftypes.Object:
+		var fields []string
+		for _, name := range sortedKeys(t.AttributeTypes) {
+			fields = append(fields, name+" = "+hclType(t.AttributeTypes[name]))
+		}
+		return "object({ " + strings.Join(fields, ", ") + " })"
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		return "string"
+	case typ.Is(tftypes.Number):
+		return "number"
+	case typ.Is(tftypes.Bool):
+		return "bool"
+	}
+	return "any"
+}
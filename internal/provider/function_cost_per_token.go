@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &CostPerTokenFunction{}
+
+func NewCostPerTokenFunction() function.Function {
+	return &CostPerTokenFunction{}
+}
+
+type CostPerTokenFunction struct{}
+
+func (f *CostPerTokenFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cost_per_token"
+}
+
+func (f *CostPerTokenFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Converts a cost per million tokens to a cost per token.",
+		Description: "Converts a cost per million tokens to the per-token cost LiteLLM stores in input_cost_per_token and output_cost_per_token, without float noise.",
+		Parameters: []function.Parameter{
+			function.Float64Parameter{
+				Name:        "per_million",
+				Description: "Cost per million tokens.",
+			},
+		},
+		Return: function.Float64Return{},
+	}
+}
+
+func (f *CostPerTokenFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var perMillion float64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &perMillion))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, costPerToken(perMillion)))
+}
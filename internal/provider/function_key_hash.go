@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &KeyHashFunction{}
+
+func NewKeyHashFunction() function.Function {
+	return &KeyHashFunction{}
+}
+
+type KeyHashFunction struct{}
+
+func (f *KeyHashFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "key_hash"
+}
+
+func (f *KeyHashFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Computes the hashed token LiteLLM stores for a key.",
+		Description: "Computes the hashed token LiteLLM identifies a virtual key by, the SHA-256 hex digest of keys starting with sk-. Other values are returned unchanged, as the proxy does. The result can reference or import a key without holding the secret.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "key",
+				Description: "Virtual key, e.g. sk-1234.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *KeyHashFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var key string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &key))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, hashKeyToken(key)))
+}
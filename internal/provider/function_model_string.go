@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ModelStringFunction{}
+
+func NewModelStringFunction() function.Function {
+	return &ModelStringFunction{}
+}
+
+type ModelStringFunction struct{}
+
+func (f *ModelStringFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "model_string"
+}
+
+func (f *ModelStringFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Builds the model string litellm_model sends for a deployment.",
+		Description: "Builds the litellm_params model that litellm_model sends for a deployment, the base model prefixed with its provider, e.g. azure/gpt-4o.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "custom_llm_provider",
+				Description: "Provider of the deployment, as in litellm_model custom_llm_provider.",
+			},
+			function.StringParameter{
+				Name:        "base_model",
+				Description: "Base model of the deployment, as in litellm_model base_model.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ModelStringFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var customLLMProvider, baseModel string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &customLLMProvider, &baseModel))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, modelString(customLLMProvider, baseModel)))
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ParseBudgetDurationFunction{}
+
+func NewParseBudgetDurationFunction() function.Function {
+	return &ParseBudgetDurationFunction{}
+}
+
+type ParseBudgetDurationFunction struct{}
+
+// budgetDurationPattern matches the durations the proxy accepts for
+// budget_duration, e.g. 30s, 10m, 24h, 30d, 2w or 1mo.
+var budgetDurationPattern = regexp.MustCompile(`^(\d+)(s|m|h|d|w|mo)$`)
+
+// budgetDurationUnits are the seconds per unit. The proxy resets monthly
+// budgets on the same day of the next month; a month counts as 30 days here.
+var budgetDurationUnits = map[string]int64{
+	"s":  1,
+	"m":  60,
+	"h":  3600,
+	"d":  86400,
+	"w":  604800,
+	"mo": 30 * 86400,
+}
+
+func (f *ParseBudgetDurationFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_budget_duration"
+}
+
+func (f *ParseBudgetDurationFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Converts a budget_duration to seconds.",
+		Description: "Converts a budget_duration such as 30s, 10m, 24h, 30d, 2w or 1mo to seconds, failing for durations the proxy does not accept. A month counts as 30 days.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "duration",
+				Description: "Budget duration, a number followed by s, m, h, d, w or mo.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *ParseBudgetDurationFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var duration string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &duration))
+	if resp.Error != nil {
+		return
+	}
+
+	seconds, err := parseBudgetDuration(duration)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, seconds))
+}
+
+func parseBudgetDuration(duration string) (int64, error) {
+	match := budgetDurationPattern.FindStringSubmatch(duration)
+	if match == nil {
+		return 0, fmt.Errorf("invalid budget duration %q, expected a number followed by s, m, h, d, w or mo, e.g. 30d", duration)
+	}
+
+	value, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid budget duration %q: %s", duration, err)
+	}
+	return value * budgetDurationUnits[match[2]], nil
+}
@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ListFilterModel narrows the items returned by a list data source. It is
+// shared by the keys, users, teams, models, tags and MCP servers data sources.
+type ListFilterModel struct {
+	NameRegex     types.String  `tfsdk:"name_regex"`
+	AliasRegex    types.String  `tfsdk:"alias_regex"`
+	Metadata      types.Map     `tfsdk:"metadata"`
+	Blocked       types.Bool    `tfsdk:"blocked"`
+	SpendMin      types.Float64 `tfsdk:"spend_min"`
+	SpendMax      types.Float64 `tfsdk:"spend_max"`
+	BudgetMin     types.Float64 `tfsdk:"budget_min"`
+	BudgetMax     types.Float64 `tfsdk:"budget_max"`
+	CreatedAfter  types.String  `tfsdk:"created_after"`
+	CreatedBefore types.String  `tfsdk:"created_before"`
+}
+
+// listFilterFields names the response fields a data source's items are
+// filtered on. Dotted names reach into nested objects, and an empty name marks
+// a filter the data source does not support.
+type listFilterFields struct {
+	Name      string
+	Alias     string
+	Metadata  string
+	Blocked   string
+	Spend     string
+	Budget    string
+	CreatedAt string
+}
+
+func listFilterBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "Filters applied to the listed items. Filters the proxy supports are sent as query parameters, the rest are evaluated by the provider. All set filters must match.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression the item's name must match.",
+				Optional:    true,
+			},
+			"alias_regex": schema.StringAttribute{
+				Description: "Regular expression the item's alias must match.",
+				Optional:    true,
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Metadata key/value pairs the item must have.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"blocked": schema.BoolAttribute{
+				Description: "Only return blocked (true) or unblocked (false) items.",
+				Optional:    true,
+			},
+			"spend_min": schema.Float64Attribute{
+				Description: "Only return items that have spent at least this amount.",
+				Optional:    true,
+			},
+			"spend_max": schema.Float64Attribute{
+				Description: "Only return items that have spent at most this amount.",
+				Optional:    true,
+			},
+			"budget_min": schema.Float64Attribute{
+				Description: "Only return items with a max budget of at least this amount.",
+				Optional:    true,
+			},
+			"budget_max": schema.Float64Attribute{
+				Description: "Only return items with a max budget of at most this amount.",
+				Optional:    true,
+			},
+			"created_after": schema.StringAttribute{
+				Description: "Only return items created at or after this RFC 3339 timestamp.",
+				Optional:    true,
+			},
+			"created_before": schema.StringAttribute{
+				Description: "Only return items created before this RFC 3339 timestamp.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// listFilter is the compiled form of a ListFilterModel.
+type listFilter struct {
+	fields        listFilterFields
+	name          *regexp.Regexp
+	alias         *regexp.Regexp
+	metadata      map[string]string
+	blocked       *bool
+	spendMin      *float64
+	spendMax      *float64
+	budgetMin     *float64
+	budgetMax     *float64
+	createdAfter  *time.Time
+	createdBefore *time.Time
+}
+
+// newListFilter compiles the filter block of a data source. It returns nil
+// when the block is absent, and reports filters the data source cannot apply.
+func newListFilter(ctx context.Context, f *ListFilterModel, fields listFilterFields, typeName string) (*listFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if f == nil {
+		return nil, diags
+	}
+
+	root := path.Root("filter")
+	lf := &listFilter{fields: fields}
+
+	unsupported := func(attr, field string) bool {
+		if field != "" {
+			return false
+		}
+		diags.AddAttributeError(
+			root.AtName(attr),
+			"Unsupported Filter",
+			fmt.Sprintf("%s does not support filtering on %s.", typeName, attr),
+		)
+		return true
+	}
+
+	compile := func(attr string, value types.String, field string) *regexp.Regexp {
+		if value.IsNull() || unsupported(attr, field) {
+			return nil
+		}
+		re, err := regexp.Compile(value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(root.AtName(attr), "Invalid Regular Expression", err.Error())
+			return nil
+		}
+		return re
+	}
+	lf.name = compile("name_regex", f.NameRegex, fields.Name)
+	lf.alias = compile("alias_regex", f.AliasRegex, fields.Alias)
+
+	if !f.Metadata.IsNull() && !unsupported("metadata", fields.Metadata) {
+		f.Metadata.ElementsAs(ctx, &lf.metadata, false)
+	}
+
+	if !f.Blocked.IsNull() && !unsupported("blocked", fields.Blocked) {
+		blocked := f.Blocked.ValueBool()
+		lf.blocked = &blocked
+	}
+
+	bound := func(attr string, value types.Float64, field string) *float64 {
+		if value.IsNull() || unsupported(attr, field) {
+			return nil
+		}
+		v := value.ValueFloat64()
+		return &v
+	}
+	lf.spendMin = bound("spend_min", f.SpendMin, fields.Spend)
+	lf.spendMax = bound("spend_max", f.SpendMax, fields.Spend)
+	lf.budgetMin = bound("budget_min", f.BudgetMin, fields.Budget)
+	lf.budgetMax = bound("budget_max", f.BudgetMax, fields.Budget)
+
+	date := func(attr string, value types.String) *time.Time {
+		if value.IsNull() || unsupported(attr, fields.CreatedAt) {
+			return nil
+		}
+		t, err := time.Parse(time.RFC3339, value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(root.AtName(attr), "Invalid Timestamp", fmt.Sprintf("Expected an RFC 3339 timestamp: %s", err))
+			return nil
+		}
+		return &t
+	}
+	lf.createdAfter = date("created_after", f.CreatedAfter)
+	lf.createdBefore = date("created_before", f.CreatedBefore)
+
+	return lf, diags
+}
+
+// Match reports whether a raw list item passes every filter. Items missing a
+// field that is filtered on do not match, except that a missing blocked flag
+// counts as unblocked. A nil filter matches everything.
+func (lf *listFilter) Match(item interface{}) bool {
+	if lf == nil {
+		return true
+	}
+	m, ok := item.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	if lf.name != nil {
+		name, _ := lookupField(m, lf.fields.Name).(string)
+		if !lf.name.MatchString(name) {
+			return false
+		}
+	}
+	if lf.alias != nil {
+		alias, _ := lookupField(m, lf.fields.Alias).(string)
+		if !lf.alias.MatchString(alias) {
+			return false
+		}
+	}
+
+	if len(lf.metadata) > 0 {
+		metadata, _ := lookupField(m, lf.fields.Metadata).(map[string]interface{})
+		for k, want := range lf.metadata {
+			got, ok := metadata[k]
+			if !ok || got == nil || fmt.Sprint(got) != want {
+				return false
+			}
+		}
+	}
+
+	if lf.blocked != nil {
+		blocked, _ := lookupField(m, lf.fields.Blocked).(bool)
+		if blocked != *lf.blocked {
+			return false
+		}
+	}
+
+	if !inBounds(lookupField(m, lf.fields.Spend), lf.spendMin, lf.spendMax) {
+		return false
+	}
+	if !inBounds(lookupField(m, lf.fields.Budget), lf.budgetMin, lf.budgetMax) {
+		return false
+	}
+
+	if lf.createdAfter != nil || lf.createdBefore != nil {
+		createdAt, ok := parseAPITime(lookupField(m, lf.fields.CreatedAt))
+		if !ok {
+			return false
+		}
+		if lf.createdAfter != nil && createdAt.Before(*lf.createdAfter) {
+			return false
+		}
+		if lf.createdBefore != nil && !createdAt.Before(*lf.createdBefore) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// regexLiteral returns the string a regular expression matches when it contains no
+// metacharacters, and whether it is anchored at both ends. Such filters can be
+// pushed down to the proxy as plain query parameters.
+func regexLiteral(re *regexp.Regexp) (value string, exact bool, ok bool) {
+	if re == nil {
+		return "", false, false
+	}
+	parsed, err := syntax.Parse(re.String(), syntax.Perl)
+	if err != nil {
+		return "", false, false
+	}
+
+	isLiteral := func(r *syntax.Regexp) bool {
+		return r.Op == syntax.OpLiteral && r.Flags&syntax.FoldCase == 0
+	}
+
+	if isLiteral(parsed) {
+		return string(parsed.Rune), false, true
+	}
+	if parsed.Op == syntax.OpConcat && len(parsed.Sub) == 3 &&
+		parsed.Sub[0].Op == syntax.OpBeginText &&
+		isLiteral(parsed.Sub[1]) &&
+		parsed.Sub[2].Op == syntax.OpEndText {
+		return string(parsed.Sub[1].Rune), true, true
+	}
+	return "", false, false
+}
+
+// nameLiteral returns the literal form of name_regex, if it has one.
+func (lf *listFilter) nameLiteral() (value string, exact bool, ok bool) {
+	if lf == nil {
+		return "", false, false
+	}
+	return regexLiteral(lf.name)
+}
+
+// aliasLiteral returns the literal form of alias_regex, if it has one.
+func (lf *listFilter) aliasLiteral() (value string, exact bool, ok bool) {
+	if lf == nil {
+		return "", false, false
+	}
+	return regexLiteral(lf.alias)
+}
+
+// lookupField returns the value at a dotted field path, or nil.
+func lookupField(m map[string]interface{}, field string) interface{} {
+	if field == "" {
+		return nil
+	}
+	var value interface{} = m
+	for _, part := range strings.Split(field, ".") {
+		nested, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = nested[part]
+	}
+	return value
+}
+
+func inBounds(value interface{}, lower, upper *float64) bool {
+	if lower == nil && upper == nil {
+		return true
+	}
+	v, ok := value.(float64)
+	if !ok {
+		return false
+	}
+	return (lower == nil || v >= *lower) && (upper == nil || v <= *upper)
+}
+
+// apiTimeLayouts are the timestamp formats the proxy returns. Timestamps
+// without a zone are in UTC.
+var apiTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+func parseAPITime(value interface{}) (time.Time, bool) {
+	s, ok := value.(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	for _, layout := range apiTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
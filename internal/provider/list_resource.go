@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// listedObject is an object found by a list resource.
+type listedObject struct {
+	id          string
+	displayName string
+}
+
+// listResults streams objects as the results of a list resource, up to the
+// limit Terraform asks for. When Terraform also asks for the resource itself,
+// as terraform query -generate-config-out does, each object is imported and
+// read the same way terraform import would.
+func listResults(ctx context.Context, req list.ListRequest, r resource.ResourceWithImportState, objects []listedObject) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, object := range objects {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = object.displayName
+			result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), object.id)...)
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				result.Diagnostics.Append(readListedResource(ctx, r, object.id, result.Resource, result.Identity)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+func readListedResource(ctx context.Context, r resource.ResourceWithImportState, id string, res *tfsdk.Resource, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	state := tfsdk.State{Schema: res.Schema, Raw: res.Raw.Copy()}
+
+	importResp := resource.ImportStateResponse{State: state, Identity: identity}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: id}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		return importResp.Diagnostics
+	}
+
+	readResp := resource.ReadResponse{State: importResp.State, Identity: identity}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State, Identity: identity}, &readResp)
+	diags := append(importResp.Diagnostics, readResp.Diagnostics...)
+	if diags.HasError() {
+		return diags
+	}
+	if readResp.State.Raw.IsNull() {
+		diags.AddError("Client Error", fmt.Sprintf("Listed object %s no longer exists", id))
+		return diags
+	}
+
+	res.Raw = readResp.State.Raw
+	return diags
+}
+
+// listMaxItems converts the limit of a list request to ListOptions.MaxItems.
+func listMaxItems(req list.ListRequest) int {
+	if req.Limit <= 0 {
+		return 0
+	}
+	return int(req.Limit)
+}
+
+// listedObjects picks the ID and display name of each listed item. Fields are
+// dotted paths; the first non-empty display field is used, falling back to
+// the ID.
+func listedObjects(items []interface{}, idField string, displayFields ...string) []listedObject {
+	objects := make([]listedObject, 0, len(items))
+	for _, item := range items {
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id, _ := lookupField(itemMap, idField).(string)
+		if id == "" {
+			continue
+		}
+
+		object := listedObject{id: id, displayName: id}
+		for _, field := range displayFields {
+			if name, ok := lookupField(itemMap, field).(string); ok && name != "" {
+				object.displayName = name
+				break
+			}
+		}
+		objects = append(objects, object)
+	}
+	return objects
+}
+
+// listErrorResults reports a failed listing.
+func listErrorResults(summary, detail string) iter.Seq[list.ListResult] {
+	var diags diag.Diagnostics
+	diags.AddError(summary, detail)
+	return list.ListResultsStreamDiagnostics(diags)
+}
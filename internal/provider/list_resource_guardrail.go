@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+)
+
+var _ list.ListResourceWithConfigure = &GuardrailResource{}
+
+func NewGuardrailListResource() list.ListResource {
+	return &GuardrailResource{}
+}
+
+func (r *GuardrailResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists guardrails for terraform query, to import them as litellm_guardrail.",
+	}
+}
+
+func (r *GuardrailResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	items, err := r.client.ListAll(ctx, "/guardrails/list", ListOptions{
+		ItemsKeys: []string{"guardrails", "data"},
+		MaxItems:  listMaxItems(req),
+	})
+	if err != nil {
+		stream.Results = listErrorResults("Client Error", fmt.Sprintf("Unable to list guardrails: %s", err))
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r, listedObjects(items, "guardrail_id", "guardrail_name"))
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &KeyResource{}
+
+func NewKeyListResource() list.ListResource {
+	return &KeyResource{}
+}
+
+type KeyListResourceModel struct {
+	TeamID   types.String `tfsdk:"team_id"`
+	UserID   types.String `tfsdk:"user_id"`
+	KeyAlias types.String `tfsdk:"key_alias"`
+}
+
+func (r *KeyResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists keys for terraform query, to import them as litellm_key. Keys are identified by their hashed token.",
+		Attributes: map[string]listschema.Attribute{
+			"team_id": listschema.StringAttribute{
+				Description: "Only list keys of this team.",
+				Optional:    true,
+			},
+			"user_id": listschema.StringAttribute{
+				Description: "Only list keys of this user.",
+				Optional:    true,
+			},
+			"key_alias": listschema.StringAttribute{
+				Description: "Only list the key with this alias.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *KeyResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config KeyListResourceModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	params := url.Values{}
+	params.Set("return_full_object", "true")
+	if !config.TeamID.IsNull() && config.TeamID.ValueString() != "" {
+		params.Set("team_id", config.TeamID.ValueString())
+	}
+	if !config.UserID.IsNull() && config.UserID.ValueString() != "" {
+		params.Set("user_id", config.UserID.ValueString())
+	}
+	if !config.KeyAlias.IsNull() && config.KeyAlias.ValueString() != "" {
+		params.Set("key_alias", config.KeyAlias.ValueString())
+	}
+	endpoint := "/key/list?" + params.Encode()
+
+	items, err := r.client.ListAll(ctx, endpoint, ListOptions{
+		ItemsKeys: []string{"keys", "data"},
+		PageParam: "page",
+		SizeParam: "size",
+		MaxItems:  listMaxItems(req),
+	})
+	if err != nil {
+		stream.Results = listErrorResults("Client Error", fmt.Sprintf("Unable to list keys: %s", err))
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r, listedObjects(items, "token", "key_alias", "key_name"))
+}
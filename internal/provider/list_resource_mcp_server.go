@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+)
+
+var _ list.ListResourceWithConfigure = &MCPServerResource{}
+
+func NewMCPServerListResource() list.ListResource {
+	return &MCPServerResource{}
+}
+
+func (r *MCPServerResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists MCP servers for terraform query, to import them as litellm_mcp_server.",
+	}
+}
+
+func (r *MCPServerResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	items, err := r.client.ListAll(ctx, "/v1/mcp/server", ListOptions{
+		ItemsKeys: []string{"data", "servers"},
+		MaxItems:  listMaxItems(req),
+	})
+	if err != nil {
+		stream.Results = listErrorResults("Client Error", fmt.Sprintf("Unable to list MCP servers: %s", err))
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r, listedObjects(items, "server_id", "server_name", "alias"))
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &ModelResource{}
+
+func NewModelListResource() list.ListResource {
+	return &ModelResource{}
+}
+
+type ModelListResourceModel struct {
+	TeamID types.String `tfsdk:"team_id"`
+}
+
+func (r *ModelResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists model deployments for terraform query, to import them as litellm_model.",
+		Attributes: map[string]listschema.Attribute{
+			"team_id": listschema.StringAttribute{
+				Description: "Only list models of this team.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *ModelResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config ModelListResourceModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	endpoint := "/model/info"
+	if !config.TeamID.IsNull() && config.TeamID.ValueString() != "" {
+		endpoint += "?team_id=" + url.QueryEscape(config.TeamID.ValueString())
+	}
+
+	items, err := r.client.ListAll(ctx, endpoint, ListOptions{
+		ItemsKeys: []string{"data", "models"},
+		MaxItems:  listMaxItems(req),
+	})
+	if err != nil {
+		stream.Results = listErrorResults("Client Error", fmt.Sprintf("Unable to list models: %s", err))
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r, listedObjects(items, "model_info.id", "model_name"))
+}
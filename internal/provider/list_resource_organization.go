@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+)
+
+var _ list.ListResourceWithConfigure = &OrganizationResource{}
+
+func NewOrganizationListResource() list.ListResource {
+	return &OrganizationResource{}
+}
+
+func (r *OrganizationResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists organizations for terraform query, to import them as litellm_organization.",
+	}
+}
+
+func (r *OrganizationResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	items, err := r.client.ListAll(ctx, "/organization/list", ListOptions{
+		ItemsKeys: []string{"organizations", "data"},
+		MaxItems:  listMaxItems(req),
+	})
+	if err != nil {
+		stream.Results = listErrorResults("Client Error", fmt.Sprintf("Unable to list organizations: %s", err))
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r, listedObjects(items, "organization_id", "organization_alias"))
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+)
+
+var _ list.ListResourceWithConfigure = &TagResource{}
+
+func NewTagListResource() list.ListResource {
+	return &TagResource{}
+}
+
+func (r *TagResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists tags for terraform query, to import them as litellm_tag.",
+	}
+}
+
+func (r *TagResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	items, err := r.client.ListAll(ctx, "/tag/list", ListOptions{
+		ItemsKeys: []string{"tags", "data"},
+		MaxItems:  listMaxItems(req),
+	})
+	if err != nil {
+		stream.Results = listErrorResults("Client Error", fmt.Sprintf("Unable to list tags: %s", err))
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r, listedObjects(items, "name"))
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &TeamResource{}
+
+func NewTeamListResource() list.ListResource {
+	return &TeamResource{}
+}
+
+type TeamListResourceModel struct {
+	OrganizationID types.String `tfsdk:"organization_id"`
+}
+
+func (r *TeamResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists teams for terraform query, to import them as litellm_team.",
+		Attributes: map[string]listschema.Attribute{
+			"organization_id": listschema.StringAttribute{
+				Description: "Only list teams of this organization.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *TeamResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config TeamListResourceModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	endpoint := "/v2/team/list"
+	if !config.OrganizationID.IsNull() && config.OrganizationID.ValueString() != "" {
+		endpoint += "?organization_id=" + url.QueryEscape(config.OrganizationID.ValueString())
+	}
+
+	items, err := r.client.ListAll(ctx, endpoint, ListOptions{
+		ItemsKeys: []string{"teams", "data"},
+		PageParam: "page",
+		SizeParam: "page_size",
+		MaxItems:  listMaxItems(req),
+	})
+	if err != nil {
+		stream.Results = listErrorResults("Client Error", fmt.Sprintf("Unable to list teams: %s", err))
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r, listedObjects(items, "team_id", "team_alias"))
+}
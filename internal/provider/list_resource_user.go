@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &UserResource{}
+
+func NewUserListResource() list.ListResource {
+	return &UserResource{}
+}
+
+type UserListResourceModel struct {
+	Role types.String `tfsdk:"role"`
+}
+
+func (r *UserResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists users for terraform query, to import them as litellm_user.",
+		Attributes: map[string]listschema.Attribute{
+			"role": listschema.StringAttribute{
+				Description: "Only list users with this role, e.g. internal_user.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *UserResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config UserListResourceModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	endpoint := "/user/list"
+	if !config.Role.IsNull() && config.Role.ValueString() != "" {
+		endpoint += "?role=" + url.QueryEscape(config.Role.ValueString())
+	}
+
+	items, err := r.client.ListAll(ctx, endpoint, ListOptions{
+		ItemsKeys: []string{"users", "data"},
+		PageParam: "page",
+		SizeParam: "page_size",
+		MaxItems:  listMaxItems(req),
+	})
+	if err != nil {
+		stream.Results = listErrorResults("Client Error", fmt.Sprintf("Unable to list users: %s", err))
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r, listedObjects(items, "user_id", "user_email", "user_alias"))
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// ModelCostMap returns LiteLLM's model cost map. It is cached on the client
+// once fetched, as it is several megabytes. Failures are not cached, so a
+// cancelled or timed-out caller doesn't fail the calls that follow it.
+func (c *Client) ModelCostMap(ctx context.Context) (map[string]interface{}, error) {
+	c.costMapMu.Lock()
+	defer c.costMapMu.Unlock()
+
+	if c.costMap != nil {
+		return c.costMap, nil
+	}
+
+	var costMap map[string]interface{}
+	if err := c.DoRequestWithResponse(ctx, "GET", "/public/litellm_model_cost_map", nil, &costMap); err != nil {
+		return nil, err
+	}
+	if costMap == nil {
+		costMap = map[string]interface{}{}
+	}
+	c.costMap = costMap
+	return costMap, nil
+}
+
+// lookupModelCost finds the cost map entry of a model. The map keys some
+// models by provider/model (e.g. azure/gpt-4o) and others by the bare model
+// name, so both are tried.
+func lookupModelCost(costMap map[string]interface{}, provider, model string) (string, map[string]interface{}, bool) {
+	candidates := []string{}
+	if provider != "" && !strings.HasPrefix(model, provider+"/") {
+		candidates = append(candidates, provider+"/"+model)
+	}
+	candidates = append(candidates, model)
+
+	for _, key := range candidates {
+		if entry, ok := costMap[key].(map[string]interface{}); ok {
+			return key, entry, true
+		}
+	}
+	return "", nil, false
+}
+
+// costPerMillionTokens converts a per-token cost from the cost map, dropping
+// the float noise of the multiplication (e.g. 2.4999999999999996).
+func costPerMillionTokens(perToken float64) float64 {
+	cost, err := strconv.ParseFloat(strconv.FormatFloat(perToken*1000000, 'g', 12, 64), 64)
+	if err != nil {
+		return perToken * 1000000
+	}
+	return cost
+}
+
+// costPerToken converts a cost per million tokens to the per-token cost the
+// proxy stores, dropping float noise the same way.
+func costPerToken(perMillion float64) float64 {
+	cost, err := strconv.ParseFloat(strconv.FormatFloat(perMillion/1000000, 'g', 12, 64), 64)
+	if err != nil {
+		return perMillion / 1000000
+	}
+	return cost
+}
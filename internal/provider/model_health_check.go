@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ModelHealthCheckSettingsModel configures the health check litellm_model runs
+// after create and update.
+type ModelHealthCheckSettingsModel struct {
+	Enabled         types.Bool  `tfsdk:"enabled"`
+	Timeout         types.Int64 `tfsdk:"timeout"`
+	FailOnUnhealthy types.Bool  `tfsdk:"fail_on_unhealthy"`
+}
+
+const (
+	defaultModelHealthCheckTimeout = 60 * time.Second
+
+	modelHealthHealthy   = "healthy"
+	modelHealthUnhealthy = "unhealthy"
+	modelHealthUnknown   = "unknown"
+)
+
+// modelHealthCheckRetryInterval is the wait between checks of a deployment the
+// proxy has not loaded yet.
+var modelHealthCheckRetryInterval = 2 * time.Second
+
+func modelHealthCheckBlockSchema() schema.Block {
+	return schema.SingleNestedBlock{
+		Description: "Calls the deployment through the proxy's /health endpoint after create and update, so a wrong API key or base URL is reported by the apply rather than by the first user. The result is stored in last_health_status.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Whether to run the health check. Defaults to true.",
+				Optional:    true,
+			},
+			"timeout": schema.Int64Attribute{
+				Description: "Seconds to wait for the check, including waiting for the proxy to load the deployment. Defaults to 60.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"fail_on_unhealthy": schema.BoolAttribute{
+				Description: "Fail the apply when the deployment is unhealthy instead of warning. A created deployment is kept but marked tainted. Defaults to false.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// enabled reports whether the health check block is present and not disabled.
+func (h *ModelHealthCheckSettingsModel) enabled() bool {
+	if h == nil {
+		return false
+	}
+	return h.Enabled.IsNull() || h.Enabled.IsUnknown() || h.Enabled.ValueBool()
+}
+
+// runHealthCheck checks the deployment when the health_check block asks for it,
+// sets last_health_status and reports a failing check as a warning, or as an
+// error with fail_on_unhealthy.
+func (r *ModelResource) runHealthCheck(ctx context.Context, data *ModelResourceModel, diags *diag.Diagnostics) {
+	if !data.HealthCheck.enabled() {
+		data.LastHealthStatus = types.StringNull()
+		return
+	}
+
+	timeout := defaultModelHealthCheckTimeout
+	if !data.HealthCheck.Timeout.IsNull() {
+		timeout = time.Duration(data.HealthCheck.Timeout.ValueInt64()) * time.Second
+	}
+
+	status, err := r.checkModelHealth(ctx, data.ID.ValueString(), timeout)
+	data.LastHealthStatus = types.StringValue(status)
+	if err == nil {
+		return
+	}
+
+	detail := fmt.Sprintf("Model %s (%s) failed its health check: %s", data.ModelName.ValueString(), data.ID.ValueString(), err)
+	if data.HealthCheck.FailOnUnhealthy.ValueBool() {
+		diags.AddError("Model Health Check Failed", detail)
+	} else {
+		diags.AddWarning("Model Health Check Failed", detail)
+	}
+}
+
+// checkModelHealth runs /health for a single deployment. A proxy that has not
+// loaded the deployment yet reports no endpoints at all, so the check is
+// repeated until it does or the timeout expires.
+func (r *ModelResource) checkModelHealth(ctx context.Context, modelID string, timeout time.Duration) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	endpoint := "/health?model_id=" + url.QueryEscape(modelID)
+	answered := false
+	for {
+		var result map[string]interface{}
+		if err := r.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
+			switch {
+			case ctx.Err() != nil && answered:
+				return modelHealthUnknown, fmt.Errorf("the proxy did not report the deployment within %s", timeout)
+			case ctx.Err() != nil:
+				return modelHealthUnknown, fmt.Errorf("no result within %s", timeout)
+			}
+			return modelHealthUnknown, err
+		}
+		answered = true
+
+		healthy, _ := result["healthy_endpoints"].([]interface{})
+		unhealthy, _ := result["unhealthy_endpoints"].([]interface{})
+		if len(unhealthy) > 0 {
+			return modelHealthUnhealthy, fmt.Errorf("%s", modelHealthEndpointError(unhealthy[0]))
+		}
+		if len(healthy) > 0 {
+			return modelHealthHealthy, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return modelHealthUnknown, fmt.Errorf("the proxy did not report the deployment within %s", timeout)
+		case <-time.After(modelHealthCheckRetryInterval):
+		}
+	}
+}
+
+// modelHealthEndpointError returns the upstream error of an unhealthy endpoint,
+// without the stack trace the proxy appends to it.
+func modelHealthEndpointError(endpoint interface{}) string {
+	endpointMap, _ := endpoint.(map[string]interface{})
+	message, _ := endpointMap["error"].(string)
+	if i := strings.Index(message, "stack trace:"); i >= 0 {
+		message = message[:i]
+	}
+	message = strings.TrimSpace(message)
+	if message == "" {
+		return "the deployment is unhealthy"
+	}
+	return message
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newHealthCheckTestResource returns a model resource whose client talks to a
+// fake proxy. respond returns the /health response for the nth call, from 1.
+func newHealthCheckTestResource(t *testing.T, respond func(call int) map[string]interface{}) (*ModelResource, *int32) {
+	t.Helper()
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" || r.URL.Query().Get("model_id") != "model-1" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		call := atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(respond(int(call)))
+	}))
+	t.Cleanup(server.Close)
+
+	previous := modelHealthCheckRetryInterval
+	modelHealthCheckRetryInterval = 10 * time.Millisecond
+	t.Cleanup(func() { modelHealthCheckRetryInterval = previous })
+
+	return &ModelResource{client: &Client{APIBase: server.URL, HTTPClient: server.Client()}}, &calls
+}
+
+func healthResponse(healthy, unhealthy []interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"healthy_endpoints":   healthy,
+		"unhealthy_endpoints": unhealthy,
+	}
+}
+
+func TestCheckModelHealth(t *testing.T) {
+	healthy := []interface{}{map[string]interface{}{"model": "openai/gpt-4o"}}
+	unhealthy := []interface{}{map[string]interface{}{
+		"model": "openai/gpt-4o",
+		"error": "AuthenticationError: Incorrect API key provided\nstack trace: Traceback (most recent call last):",
+	}}
+
+	tests := []struct {
+		name      string
+		respond   func(call int) map[string]interface{}
+		timeout   time.Duration
+		status    string
+		err       string
+		wantCalls int32
+	}{
+		{
+			name:      "healthy",
+			respond:   func(int) map[string]interface{} { return healthResponse(healthy, nil) },
+			timeout:   time.Second,
+			status:    modelHealthHealthy,
+			wantCalls: 1,
+		},
+		{
+			name:      "unhealthy",
+			respond:   func(int) map[string]interface{} { return healthResponse(nil, unhealthy) },
+			timeout:   time.Second,
+			status:    modelHealthUnhealthy,
+			err:       "AuthenticationError: Incorrect API key provided",
+			wantCalls: 1,
+		},
+		{
+			name: "not yet loaded",
+			respond: func(call int) map[string]interface{} {
+				if call < 3 {
+					return healthResponse(nil, nil)
+				}
+				return healthResponse(healthy, nil)
+			},
+			timeout:   time.Second,
+			status:    modelHealthHealthy,
+			wantCalls: 3,
+		},
+		{
+			name:    "never loaded",
+			respond: func(int) map[string]interface{} { return healthResponse(nil, nil) },
+			timeout: 50 * time.Millisecond,
+			status:  modelHealthUnknown,
+			err:     "the proxy did not report the deployment within 50ms",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, calls := newHealthCheckTestResource(t, tt.respond)
+
+			status, err := r.checkModelHealth(context.Background(), "model-1", tt.timeout)
+			if status != tt.status {
+				t.Errorf("status = %q, want %q", status, tt.status)
+			}
+			switch {
+			case tt.err == "" && err != nil:
+				t.Errorf("unexpected error: %s", err)
+			case tt.err != "" && (err == nil || err.Error() != tt.err):
+				t.Errorf("error = %v, want %q", err, tt.err)
+			}
+			if tt.wantCalls > 0 && *calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", *calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCheckModelHealthSlowProxy(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+
+	r := &ModelResource{client: &Client{APIBase: server.URL, HTTPClient: server.Client()}}
+	status, err := r.checkModelHealth(context.Background(), "model-1", 50*time.Millisecond)
+	if status != modelHealthUnknown {
+		t.Errorf("status = %q, want %q", status, modelHealthUnknown)
+	}
+	if err == nil || err.Error() != "no result within 50ms" {
+		t.Errorf("error = %v, want %q", err, "no result within 50ms")
+	}
+}
+
+func TestRunHealthCheck(t *testing.T) {
+	unhealthy := []interface{}{map[string]interface{}{"error": "APIConnectionError: connection refused"}}
+
+	tests := []struct {
+		name        string
+		healthCheck *ModelHealthCheckSettingsModel
+		status      types.String
+		severity    diag.Severity
+		wantCalls   int32
+	}{
+		{
+			name:        "unhealthy warns",
+			healthCheck: &ModelHealthCheckSettingsModel{},
+			status:      types.StringValue(modelHealthUnhealthy),
+			severity:    diag.SeverityWarning,
+			wantCalls:   1,
+		},
+		{
+			name:        "unhealthy fails with fail_on_unhealthy",
+			healthCheck: &ModelHealthCheckSettingsModel{FailOnUnhealthy: types.BoolValue(true)},
+			status:      types.StringValue(modelHealthUnhealthy),
+			severity:    diag.SeverityError,
+			wantCalls:   1,
+		},
+		{
+			name:        "disabled",
+			healthCheck: &ModelHealthCheckSettingsModel{Enabled: types.BoolValue(false)},
+			status:      types.StringNull(),
+		},
+		{
+			name:   "no block",
+			status: types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, calls := newHealthCheckTestResource(t, func(int) map[string]interface{} {
+				return healthResponse(nil, unhealthy)
+			})
+
+			data := &ModelResourceModel{
+				ID:               types.StringValue("model-1"),
+				ModelName:        types.StringValue("gpt-4o"),
+				LastHealthStatus: types.StringValue(modelHealthHealthy),
+				HealthCheck:      tt.healthCheck,
+			}
+			var diags diag.Diagnostics
+			r.runHealthCheck(context.Background(), data, &diags)
+
+			if !data.LastHealthStatus.Equal(tt.status) {
+				t.Errorf("last_health_status = %s, want %s", data.LastHealthStatus, tt.status)
+			}
+			if *calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", *calls, tt.wantCalls)
+			}
+
+			if tt.severity == 0 {
+				if len(diags) > 0 {
+					t.Errorf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 {
+				t.Fatalf("got %d diagnostics, want 1: %v", len(diags), diags)
+			}
+			if diags[0].Severity() != tt.severity {
+				t.Errorf("severity = %s, want %s", diags[0].Severity(), tt.severity)
+			}
+			if !strings.Contains(diags[0].Detail(), "APIConnectionError: connection refused") {
+				t.Errorf("detail %q does not include the upstream error", diags[0].Detail())
+			}
+		})
+	}
+}
@@ -0,0 +1,411 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ModelAzureModel holds Azure OpenAI settings, mainly Azure AD authentication.
+type ModelAzureModel struct {
+	TenantID      types.String `tfsdk:"tenant_id"`
+	ClientID      types.String `tfsdk:"client_id"`
+	ClientSecret  types.String `tfsdk:"client_secret"`
+	AzureADToken  types.String `tfsdk:"azure_ad_token"`
+	AzureUsername types.String `tfsdk:"azure_username"`
+	AzurePassword types.String `tfsdk:"azure_password"`
+	AzureScope    types.String `tfsdk:"azure_scope"`
+}
+
+// ModelBedrockModel holds AWS settings for Bedrock and SageMaker.
+type ModelBedrockModel struct {
+	AWSAccessKeyID            types.String `tfsdk:"aws_access_key_id"`
+	AWSSecretAccessKey        types.String `tfsdk:"aws_secret_access_key"`
+	AWSSessionToken           types.String `tfsdk:"aws_session_token"`
+	AWSRegionName             types.String `tfsdk:"aws_region_name"`
+	AWSSessionName            types.String `tfsdk:"aws_session_name"`
+	AWSRoleName               types.String `tfsdk:"aws_role_name"`
+	AWSProfileName            types.String `tfsdk:"aws_profile_name"`
+	AWSWebIdentityToken       types.String `tfsdk:"aws_web_identity_token"`
+	AWSSTSEndpoint            types.String `tfsdk:"aws_sts_endpoint"`
+	AWSBedrockRuntimeEndpoint types.String `tfsdk:"aws_bedrock_runtime_endpoint"`
+	AWSExternalID             types.String `tfsdk:"aws_external_id"`
+}
+
+// ModelVertexModel holds Google Cloud settings for Vertex AI.
+type ModelVertexModel struct {
+	VertexProject     types.String `tfsdk:"vertex_project"`
+	VertexLocation    types.String `tfsdk:"vertex_location"`
+	VertexCredentials types.String `tfsdk:"vertex_credentials"`
+}
+
+// ModelOpenAIModel holds OpenAI settings.
+type ModelOpenAIModel struct {
+	Organization types.String `tfsdk:"organization"`
+}
+
+// ModelAnthropicModel holds Anthropic settings.
+type ModelAnthropicModel struct {
+	BetaFeatures types.List `tfsdk:"beta_features"`
+}
+
+// ModelWatsonxModel holds IBM watsonx.ai settings.
+type ModelWatsonxModel struct {
+	ProjectID  types.String `tfsdk:"project_id"`
+	SpaceID    types.String `tfsdk:"space_id"`
+	RegionName types.String `tfsdk:"region_name"`
+	Token      types.String `tfsdk:"token"`
+	ZenAPIKey  types.String `tfsdk:"zen_api_key"`
+}
+
+// ModelOllamaModel holds Ollama settings.
+type ModelOllamaModel struct {
+	KeepAlive types.String `tfsdk:"keep_alive"`
+	NumCtx    types.Int64  `tfsdk:"num_ctx"`
+}
+
+// modelProviderField describes one string attribute of a provider block. The
+// attribute name doubles as the litellm_params key.
+type modelProviderField struct {
+	name        string
+	sensitive   bool
+	description string
+	value       *types.String
+}
+
+// modelProviderBlock describes a provider-specific block of litellm_model.
+type modelProviderBlock struct {
+	name        string
+	description string
+	providers   []string
+	// legacy lists the top-level attributes the block replaces
+	legacy []string
+	// fields returns the string attributes of the block on data, or nil when
+	// the block is not set.
+	fields func(data *ModelResourceModel) []modelProviderField
+}
+
+func (b modelProviderBlock) providerList() string {
+	if len(b.providers) == 1 {
+		return b.providers[0]
+	}
+	return "one of " + strings.Join(b.providers, ", ")
+}
+
+var modelProviderBlocks = []modelProviderBlock{
+	{
+		name:        "azure",
+		description: "Azure OpenAI settings, e.g. Azure AD authentication. Use model_api_base and api_version for the endpoint.",
+		providers:   []string{"azure", "azure_text"},
+		fields: func(data *ModelResourceModel) []modelProviderField {
+			m := data.Azure
+			if m == nil {
+				return nil
+			}
+			return []modelProviderField{
+				{"tenant_id", false, "Azure AD tenant ID of the service principal.", &m.TenantID},
+				{"client_id", false, "Application (client) ID of the service principal.", &m.ClientID},
+				{"client_secret", true, "Client secret of the service principal.", &m.ClientSecret},
+				{"azure_ad_token", true, "Azure AD token to authenticate with instead of an API key.", &m.AzureADToken},
+				{"azure_username", false, "Username for Azure AD username/password authentication.", &m.AzureUsername},
+				{"azure_password", true, "Password for Azure AD username/password authentication.", &m.AzurePassword},
+				{"azure_scope", false, "Scope requested for Azure AD tokens.", &m.AzureScope},
+			}
+		},
+	},
+	{
+		name:        "bedrock",
+		description: "AWS settings for Bedrock and SageMaker.",
+		providers:   []string{"bedrock", "sagemaker", "sagemaker_chat"},
+		legacy:      []string{"aws_access_key_id", "aws_secret_access_key", "aws_region_name", "aws_session_name", "aws_role_name"},
+		fields: func(data *ModelResourceModel) []modelProviderField {
+			m := data.Bedrock
+			if m == nil {
+				return nil
+			}
+			return []modelProviderField{
+				{"aws_access_key_id", true, "AWS access key ID.", &m.AWSAccessKeyID},
+				{"aws_secret_access_key", true, "AWS secret access key.", &m.AWSSecretAccessKey},
+				{"aws_session_token", true, "AWS session token for temporary credentials.", &m.AWSSessionToken},
+				{"aws_region_name", false, "AWS region of the model.", &m.AWSRegionName},
+				{"aws_session_name", false, "Session name used when assuming aws_role_name.", &m.AWSSessionName},
+				{"aws_role_name", false, "ARN of an IAM role to assume.", &m.AWSRoleName},
+				{"aws_profile_name", false, "AWS profile to read credentials from.", &m.AWSProfileName},
+				{"aws_web_identity_token", true, "Web identity token for assuming aws_role_name, e.g. from OIDC.", &m.AWSWebIdentityToken},
+				{"aws_sts_endpoint", false, "STS endpoint used to assume aws_role_name.", &m.AWSSTSEndpoint},
+				{"aws_bedrock_runtime_endpoint", false, "Bedrock runtime endpoint, e.g. for a VPC endpoint.", &m.AWSBedrockRuntimeEndpoint},
+				{"aws_external_id", false, "External ID used when assuming aws_role_name.", &m.AWSExternalID},
+			}
+		},
+	},
+	{
+		name:        "vertex",
+		description: "Google Cloud settings for Vertex AI.",
+		providers:   []string{"vertex_ai", "vertex_ai_beta"},
+		legacy:      []string{"vertex_project", "vertex_location", "vertex_credentials"},
+		fields: func(data *ModelResourceModel) []modelProviderField {
+			m := data.Vertex
+			if m == nil {
+				return nil
+			}
+			return []modelProviderField{
+				{"vertex_project", false, "Google Cloud project.", &m.VertexProject},
+				{"vertex_location", false, "Google Cloud location, e.g. us-central1.", &m.VertexLocation},
+				{"vertex_credentials", true, "Service account credentials JSON, or a path to it on the proxy.", &m.VertexCredentials},
+			}
+		},
+	},
+	{
+		name:        "openai",
+		description: "OpenAI settings.",
+		providers:   []string{"openai", "text-completion-openai"},
+		fields: func(data *ModelResourceModel) []modelProviderField {
+			m := data.OpenAI
+			if m == nil {
+				return nil
+			}
+			return []modelProviderField{
+				{"organization", false, "OpenAI organization ID requests are billed to.", &m.Organization},
+			}
+		},
+	},
+	{
+		name:        "anthropic",
+		description: "Anthropic settings.",
+		providers:   []string{"anthropic"},
+		fields: func(data *ModelResourceModel) []modelProviderField {
+			return nil
+		},
+	},
+	{
+		name:        "ollama",
+		description: "Ollama settings. Use model_api_base for the Ollama server.",
+		providers:   []string{"ollama", "ollama_chat"},
+		fields: func(data *ModelResourceModel) []modelProviderField {
+			m := data.Ollama
+			if m == nil {
+				return nil
+			}
+			return []modelProviderField{
+				{"keep_alive", false, "How long Ollama keeps the model loaded after a request, e.g. 5m or -1 to keep it loaded.", &m.KeepAlive},
+			}
+		},
+	},
+	{
+		name:        "watsonx",
+		description: "IBM watsonx.ai settings.",
+		providers:   []string{"watsonx", "watsonx_text"},
+		fields: func(data *ModelResourceModel) []modelProviderField {
+			m := data.Watsonx
+			if m == nil {
+				return nil
+			}
+			return []modelProviderField{
+				{"project_id", false, "watsonx.ai project ID.", &m.ProjectID},
+				{"space_id", false, "watsonx.ai deployment space ID, used instead of project_id for deployed models.", &m.SpaceID},
+				{"region_name", false, "IBM Cloud region, e.g. us-south.", &m.RegionName},
+				{"token", true, "IAM token to authenticate with instead of an API key.", &m.Token},
+				{"zen_api_key", true, "Zen API key for watsonx.ai software on Cloud Pak for Data.", &m.ZenAPIKey},
+			}
+		},
+	},
+}
+
+func modelProviderBlocksSchema() map[string]schema.Block {
+	all := &ModelResourceModel{
+		Azure:     &ModelAzureModel{},
+		Bedrock:   &ModelBedrockModel{},
+		Vertex:    &ModelVertexModel{},
+		OpenAI:    &ModelOpenAIModel{},
+		Anthropic: &ModelAnthropicModel{},
+		Ollama:    &ModelOllamaModel{},
+		Watsonx:   &ModelWatsonxModel{},
+	}
+
+	blocks := map[string]schema.Block{}
+	for _, block := range modelProviderBlocks {
+		attributes := map[string]schema.Attribute{}
+		for _, field := range block.fields(all) {
+			attributes[field.name] = schema.StringAttribute{
+				Description: field.description,
+				Optional:    true,
+				Sensitive:   field.sensitive,
+			}
+		}
+		if block.name == "anthropic" {
+			attributes["beta_features"] = schema.ListAttribute{
+				Description: "Anthropic beta features to enable, sent in the anthropic-beta header, e.g. context-1m-2025-08-07.",
+				Optional:    true,
+				ElementType: types.StringType,
+			}
+		}
+		if block.name == "ollama" {
+			attributes["num_ctx"] = schema.Int64Attribute{
+				Description: "Context window size in tokens Ollama loads the model with.",
+				Optional:    true,
+			}
+		}
+
+		blocks[block.name] = schema.SingleNestedBlock{
+			Description: fmt.Sprintf("%s Only valid when custom_llm_provider is %s. Conflicts with the other provider blocks.", block.description, block.providerList()),
+			Attributes:  attributes,
+		}
+	}
+
+	return blocks
+}
+
+// addModelProviderParams adds the settings of the configured provider block to
+// litellmParams.
+func addModelProviderParams(ctx context.Context, data *ModelResourceModel, litellmParams map[string]interface{}) {
+	for _, block := range modelProviderBlocks {
+		for _, field := range block.fields(data) {
+			if !field.value.IsNull() && field.value.ValueString() != "" {
+				litellmParams[field.name] = field.value.ValueString()
+			}
+		}
+	}
+
+	if data.Anthropic != nil && !data.Anthropic.BetaFeatures.IsNull() {
+		var features []string
+		data.Anthropic.BetaFeatures.ElementsAs(ctx, &features, false)
+		if len(features) > 0 {
+			litellmParams["extra_headers"] = map[string]interface{}{
+				"anthropic-beta": strings.Join(features, ","),
+			}
+		}
+	}
+
+	if data.Ollama != nil && !data.Ollama.NumCtx.IsNull() {
+		litellmParams["num_ctx"] = data.Ollama.NumCtx.ValueInt64()
+	}
+}
+
+// readModelProviderParams maps litellm_params onto the configured provider
+// block. The proxy masks secret values, so sensitive attributes always keep
+// their prior value.
+func readModelProviderParams(ctx context.Context, data *ModelResourceModel, litellmParams map[string]interface{}) {
+	for _, block := range modelProviderBlocks {
+		for _, field := range block.fields(data) {
+			if field.sensitive {
+				continue
+			}
+			if v, ok := litellmParams[field.name].(string); ok && v != "" {
+				*field.value = types.StringValue(v)
+			}
+		}
+	}
+
+	if data.Anthropic != nil && !data.Anthropic.BetaFeatures.IsNull() {
+		headers, _ := litellmParams["extra_headers"].(map[string]interface{})
+		if beta, ok := headers["anthropic-beta"].(string); ok && beta != "" {
+			features := []string{}
+			for _, feature := range strings.Split(beta, ",") {
+				if feature = strings.TrimSpace(feature); feature != "" {
+					features = append(features, feature)
+				}
+			}
+			if listValue, diags := types.ListValueFrom(ctx, types.StringType, features); !diags.HasError() {
+				data.Anthropic.BetaFeatures = listValue
+			}
+		}
+	}
+
+	if data.Ollama != nil && !data.Ollama.NumCtx.IsNull() {
+		if numCtx, ok := litellmParams["num_ctx"].(float64); ok {
+			data.Ollama.NumCtx = types.Int64Value(int64(numCtx))
+		}
+	}
+}
+
+// modelProviderParamAttribute returns the block attribute a litellm_params key
+// is set from, e.g. azure.tenant_id.
+func modelProviderParamAttribute(key string) (string, bool) {
+	all := &ModelResourceModel{
+		Azure:   &ModelAzureModel{},
+		Bedrock: &ModelBedrockModel{},
+		Vertex:  &ModelVertexModel{},
+		OpenAI:  &ModelOpenAIModel{},
+		Ollama:  &ModelOllamaModel{},
+		Watsonx: &ModelWatsonxModel{},
+	}
+	for _, block := range modelProviderBlocks {
+		for _, field := range block.fields(all) {
+			if field.name == key {
+				return block.name + "." + field.name, true
+			}
+		}
+	}
+	if key == "num_ctx" {
+		return "ollama.num_ctx", true
+	}
+	return "", false
+}
+
+var _ resource.ConfigValidator = modelProviderBlockValidator{}
+
+// modelProviderBlockValidator checks that a provider block is only used with a
+// matching custom_llm_provider and isn't combined with the top-level attributes
+// it replaces.
+type modelProviderBlockValidator struct{}
+
+func (v modelProviderBlockValidator) Description(ctx context.Context) string {
+	return "provider blocks must match custom_llm_provider"
+}
+
+func (v modelProviderBlockValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v modelProviderBlockValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var provider types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("custom_llm_provider"), &provider)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, block := range modelProviderBlocks {
+		var value types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(block.name), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if value.IsNull() {
+			continue
+		}
+
+		if !provider.IsNull() && !provider.IsUnknown() && !containsString(block.providers, provider.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(block.name),
+				"Provider Block Mismatch",
+				fmt.Sprintf("The %s block can only be used when custom_llm_provider is %s, not %q.", block.name, block.providerList(), provider.ValueString()),
+			)
+		}
+
+		for _, name := range block.legacy {
+			var legacy types.String
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &legacy)...)
+			if !legacy.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Conflicting Provider Configuration",
+					fmt.Sprintf("%s can't be set together with the %s block. Set %s.%s instead.", name, block.name, block.name, name),
+				)
+			}
+		}
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ObjectPermissionModel restricts which MCP servers, vector stores and agents a
+// key, team, user or organization may use. It is shared by all four resources.
+type ObjectPermissionModel struct {
+	MCPServers         types.List `tfsdk:"mcp_servers"`
+	MCPAccessGroups    types.List `tfsdk:"mcp_access_groups"`
+	MCPToolPermissions types.Map  `tfsdk:"mcp_tool_permissions"`
+	VectorStores       types.List `tfsdk:"vector_stores"`
+	Agents             types.List `tfsdk:"agents"`
+	AgentAccessGroups  types.List `tfsdk:"agent_access_groups"`
+}
+
+func objectPermissionBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "Restricts which MCP servers, vector stores and agents may be used. Omitted lists leave access unrestricted.",
+		Attributes: map[string]schema.Attribute{
+			"mcp_servers": schema.ListAttribute{
+				Description: "IDs of the MCP servers that may be used.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"mcp_access_groups": schema.ListAttribute{
+				Description: "MCP access groups that may be used.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"mcp_tool_permissions": schema.MapAttribute{
+				Description: "Map of MCP server ID to the tool names allowed on that server.",
+				Optional:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
+			"vector_stores": schema.ListAttribute{
+				Description: "IDs of the vector stores that may be used.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"agents": schema.ListAttribute{
+				Description: "IDs of the agents that may be used.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"agent_access_groups": schema.ListAttribute{
+				Description: "Agent access groups that may be used.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// buildObjectPermission sends every field, with unset lists as empty, so that
+// removing an entry from the block also removes it on the proxy.
+func buildObjectPermission(ctx context.Context, op *ObjectPermissionModel) map[string]interface{} {
+	permReq := clearedObjectPermission()
+
+	lists := map[string]types.List{
+		"mcp_servers":         op.MCPServers,
+		"mcp_access_groups":   op.MCPAccessGroups,
+		"vector_stores":       op.VectorStores,
+		"agents":              op.Agents,
+		"agent_access_groups": op.AgentAccessGroups,
+	}
+	for key, list := range lists {
+		if !list.IsNull() && !list.IsUnknown() {
+			values := []string{}
+			list.ElementsAs(ctx, &values, false)
+			permReq[key] = values
+		}
+	}
+
+	if !op.MCPToolPermissions.IsNull() && !op.MCPToolPermissions.IsUnknown() {
+		toolPermissions := map[string][]string{}
+		op.MCPToolPermissions.ElementsAs(ctx, &toolPermissions, false)
+		permReq["mcp_tool_permissions"] = toolPermissions
+	}
+
+	return permReq
+}
+
+// clearedObjectPermission lifts every restriction. It is sent on update when
+// the block is removed, since omitting object_permission leaves it unchanged.
+func clearedObjectPermission() map[string]interface{} {
+	return map[string]interface{}{
+		"mcp_servers":          []string{},
+		"mcp_access_groups":    []string{},
+		"mcp_tool_permissions": map[string][]string{},
+		"vector_stores":        []string{},
+		"agents":               []string{},
+		"agent_access_groups":  []string{},
+	}
+}
+
+// findObjectPermission returns the object_permission from an info response,
+// looking at the top level first and then inside the given nested objects.
+func findObjectPermission(result map[string]interface{}, nestedKeys ...string) map[string]interface{} {
+	if op, ok := result["object_permission"].(map[string]interface{}); ok {
+		return op
+	}
+	for _, key := range nestedKeys {
+		if nested, ok := result[key].(map[string]interface{}); ok {
+			if op, ok := nested["object_permission"].(map[string]interface{}); ok {
+				return op
+			}
+		}
+	}
+	return nil
+}
+
+// readObjectPermission maps the API response onto the block. Fields the API
+// reports as empty stay null unless they were already set, so an unconfigured
+// block does not produce a diff while imports still pick up real restrictions.
+func readObjectPermission(ctx context.Context, raw map[string]interface{}, current *ObjectPermissionModel) *ObjectPermissionModel {
+	if raw == nil {
+		return current
+	}
+
+	op := current
+	if op == nil {
+		op = &ObjectPermissionModel{
+			MCPServers:         types.ListNull(types.StringType),
+			MCPAccessGroups:    types.ListNull(types.StringType),
+			MCPToolPermissions: types.MapNull(types.ListType{ElemType: types.StringType}),
+			VectorStores:       types.ListNull(types.StringType),
+			Agents:             types.ListNull(types.StringType),
+			AgentAccessGroups:  types.ListNull(types.StringType),
+		}
+	}
+
+	found := false
+	readList := func(key string, target *types.List) {
+		values, _ := raw[key].([]interface{})
+		if len(values) > 0 {
+			found = true
+		}
+		if len(values) > 0 || !target.IsNull() {
+			*target, _ = types.ListValueFrom(ctx, types.StringType, interfaceSliceToStrings(values))
+		}
+	}
+
+	readList("mcp_servers", &op.MCPServers)
+	readList("mcp_access_groups", &op.MCPAccessGroups)
+	readList("vector_stores", &op.VectorStores)
+	readList("agents", &op.Agents)
+	readList("agent_access_groups", &op.AgentAccessGroups)
+
+	toolPermissions := map[string][]string{}
+	if tools, ok := raw["mcp_tool_permissions"].(map[string]interface{}); ok {
+		for server, names := range tools {
+			if list, ok := names.([]interface{}); ok {
+				toolPermissions[server] = interfaceSliceToStrings(list)
+			}
+		}
+	}
+	if len(toolPermissions) > 0 {
+		found = true
+	}
+	if len(toolPermissions) > 0 || !op.MCPToolPermissions.IsNull() {
+		op.MCPToolPermissions, _ = types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, toolPermissions)
+	}
+
+	if current == nil && !found {
+		return nil
+	}
+	return op
+}
@@ -5,9 +5,12 @@ import (
 	"crypto/tls"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,6 +19,8 @@ import (
 
 // Ensure LiteLLMProvider satisfies various provider interfaces.
 var _ provider.Provider = &LiteLLMProvider{}
+var _ provider.ProviderWithListResources = &LiteLLMProvider{}
+var _ provider.ProviderWithFunctions = &LiteLLMProvider{}
 
 // LiteLLMProvider defines the provider implementation.
 type LiteLLMProvider struct {
@@ -32,6 +37,7 @@ type LiteLLMProviderModel struct {
 	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 	LiteLLMChangedBy   types.String `tfsdk:"litellm_changed_by"`
 	AdditionalHeaders  types.Map    `tfsdk:"additional_headers"`
+	ValidateCostMap    types.Bool   `tfsdk:"validate_model_cost_map"`
 }
 
 // Client holds the HTTP client and configuration for API calls.
@@ -41,6 +47,13 @@ type Client struct {
 	LiteLLMChangedBy  string
 	HTTPClient        *http.Client
 	AdditionalHeaders map[string]string
+
+	// ValidateModelCostMap enables checking litellm_model base models against
+	// the model cost map at plan time.
+	ValidateModelCostMap bool
+
+	costMapMu sync.Mutex
+	costMap   map[string]interface{}
 }
 
 func (p *LiteLLMProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -74,6 +87,10 @@ func (p *LiteLLMProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"validate_model_cost_map": schema.BoolAttribute{
+				Description: "Check the base_model of litellm_model resources against LiteLLM's model cost map at plan time, warning about unknown models and filling default costs and token limits. Defaults to false.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -135,24 +152,28 @@ func (p *LiteLLMProvider) Configure(ctx context.Context, req provider.ConfigureR
 		}
 	}
 
-	// Create HTTP client with TLS configuration
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: insecureSkipVerify},
-	}
-
 	client := &Client{
-		APIBase:           apiBase,
-		APIKey:            apiKey,
-		LiteLLMChangedBy:  litellmChangedBy,
-		AdditionalHeaders: additionalHeaders,
-		HTTPClient: &http.Client{
-			Transport: tr,
-			Timeout:   30 * time.Second,
-		},
+		APIBase:              apiBase,
+		APIKey:               apiKey,
+		LiteLLMChangedBy:     litellmChangedBy,
+		AdditionalHeaders:    additionalHeaders,
+		HTTPClient:           newHTTPClient(insecureSkipVerify),
+		ValidateModelCostMap: config.ValidateCostMap.ValueBool(),
 	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ListResourceData = client
+}
+
+// newHTTPClient creates the HTTP client used to call the LiteLLM API.
+func newHTTPClient(insecureSkipVerify bool) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: insecureSkipVerify},
+		},
+		Timeout: 30 * time.Second,
+	}
 }
 
 func (p *LiteLLMProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -167,6 +188,10 @@ func (p *LiteLLMProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewMCPServerResource,
 		NewCredentialResource,
 		NewVectorStoreResource,
+		NewVectorStoreFileResource,
+		NewVectorStoreIndexResource,
+		NewRAGIngestionResource,
+		NewModelGroupPublicResource,
 		NewOrganizationResource,
 		NewOrganizationMemberResource,
 		NewUserResource,
@@ -179,6 +204,31 @@ func (p *LiteLLMProvider) Resources(ctx context.Context) []func() resource.Resou
 	}
 }
 
+// ListResources returns the resources terraform query can list for bulk import.
+func (p *LiteLLMProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewModelListResource,
+		NewKeyListResource,
+		NewTeamListResource,
+		NewUserListResource,
+		NewOrganizationListResource,
+		NewMCPServerListResource,
+		NewGuardrailListResource,
+		NewTagListResource,
+	}
+}
+
+// Functions returns the provider-defined functions, called as
+// provider::litellm::<name>.
+func (p *LiteLLMProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewCostPerTokenFunction,
+		NewParseBudgetDurationFunction,
+		NewModelStringFunction,
+		NewKeyHashFunction,
+	}
+}
+
 func (p *LiteLLMProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		// Single item lookups
@@ -196,6 +246,12 @@ func (p *LiteLLMProvider) DataSources(ctx context.Context) []func() datasource.D
 		NewGuardrailDataSource,
 		NewMCPServerDataSource,
 		NewSearchToolDataSource,
+		NewRAGQueryDataSource,
+		NewRouterSettingsDataSource,
+		NewModelGroupDataSource,
+		NewModelHubDataSource,
+		NewModelCostDataSource,
+		NewProxyConfigDataSource,
 		// List data sources
 		NewModelsListDataSource,
 		NewKeysListDataSource,
@@ -209,6 +265,20 @@ func (p *LiteLLMProvider) DataSources(ctx context.Context) []func() datasource.D
 		NewGuardrailsListDataSource,
 		NewMCPServersListDataSource,
 		NewSearchToolsListDataSource,
+		// Spend and usage data sources
+		NewSpendReportDataSource,
+		NewSpendLogsDataSource,
+		NewTagUsageDataSource,
+		NewTeamDailyActivityDataSource,
+		NewUserDailyActivityDataSource,
+		NewOrganizationDailyActivityDataSource,
+		NewTagDailyActivityDataSource,
+		NewCustomerDailyActivityDataSource,
+		NewBudgetUtilizationDataSource,
+		// Health data sources
+		NewHealthDataSource,
+		NewModelHealthDataSource,
+		NewServiceHealthDataSource,
 	}
 }
 
@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -30,6 +32,8 @@ type CredentialResourceModel struct {
 	ModelID          types.String `tfsdk:"model_id"`
 	CredentialInfo   types.Map    `tfsdk:"credential_info"`
 	CredentialValues types.Map    `tfsdk:"credential_values"`
+	VerifyOnApply    types.Bool   `tfsdk:"verify_on_apply"`
+	VerifyModel      types.String `tfsdk:"verify_model"`
 }
 
 func (r *CredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,6 +73,17 @@ func (r *CredentialResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Sensitive:   true,
 				ElementType: types.StringType,
 			},
+			"verify_on_apply": schema.BoolAttribute{
+				Description: "Test a model connection using this credential after create and update, failing the apply if it does not succeed. Requires verify_model.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("verify_model")),
+				},
+			},
+			"verify_model": schema.StringAttribute{
+				Description: "Model used for the connection test, in provider/model form (e.g., openai/gpt-4o-mini).",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -114,6 +129,12 @@ func (r *CredentialResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.VerifyOnApply.ValueBool() {
+		if err := r.verifyCredential(ctx, &data); err != nil {
+			resp.Diagnostics.AddError("Connection Test Failed", fmt.Sprintf("Credential created but the connection test failed: %s", err))
+		}
+	}
 }
 
 func (r *CredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -167,6 +188,12 @@ func (r *CredentialResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.VerifyOnApply.ValueBool() {
+		if err := r.verifyCredential(ctx, &data); err != nil {
+			resp.Diagnostics.AddError("Connection Test Failed", fmt.Sprintf("Credential updated but the connection test failed: %s", err))
+		}
+	}
 }
 
 func (r *CredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -225,6 +252,18 @@ func (r *CredentialResource) buildCredentialRequest(ctx context.Context, data *C
 	return credReq
 }
 
+// verifyCredential asks the proxy to call verify_model using the stored credential.
+func (r *CredentialResource) verifyCredential(ctx context.Context, data *CredentialResourceModel) error {
+	testReq := map[string]interface{}{
+		"litellm_params": map[string]interface{}{
+			"model":                   data.VerifyModel.ValueString(),
+			"litellm_credential_name": data.CredentialName.ValueString(),
+		},
+	}
+
+	return r.client.VerifyConnection(ctx, "/health/test_connection", testReq)
+}
+
 func (r *CredentialResource) readCredential(ctx context.Context, data *CredentialResourceModel) error {
 	credentialName := data.CredentialName.ValueString()
 	if credentialName == "" {
@@ -15,6 +15,7 @@ import (
 
 var _ resource.Resource = &GuardrailResource{}
 var _ resource.ResourceWithImportState = &GuardrailResource{}
+var _ resource.ResourceWithIdentity = &GuardrailResource{}
 
 func NewGuardrailResource() resource.Resource {
 	return &GuardrailResource{}
@@ -41,6 +42,10 @@ func (r *GuardrailResource) Metadata(ctx context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_guardrail"
 }
 
+func (r *GuardrailResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("ID of the guardrail.")
+}
+
 func (r *GuardrailResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a LiteLLM guardrail. Guardrails provide content filtering, PII detection, prompt injection protection, and more.",
@@ -150,6 +155,7 @@ func (r *GuardrailResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
 }
 
 func (r *GuardrailResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -170,6 +176,7 @@ func (r *GuardrailResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
 }
 
 func (r *GuardrailResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -204,6 +211,7 @@ func (r *GuardrailResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
 }
 
 func (r *GuardrailResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -224,8 +232,14 @@ func (r *GuardrailResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *GuardrailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("guardrail_id"), req.ID)...)
+	id, diags := importID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("guardrail_id"), id)...)
 }
 
 func (r *GuardrailResource) buildGuardrailRequest(ctx context.Context, data *GuardrailResourceModel) map[string]interface{} {
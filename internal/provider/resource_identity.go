@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceIdentityModel is the identity of the resources that can be listed
+// with terraform query: the ID they are imported with.
+type resourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func idIdentitySchema(description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       description,
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// setResourceIdentity stores id as the identity of a resource. identity is nil
+// when Terraform does not support resource identity.
+func setResourceIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id string) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, resourceIdentityModel{ID: types.StringValue(id)})
+}
+
+// importID returns the ID to import, taken from the import identifier or, for
+// import blocks with an identity, from its id.
+func importID(ctx context.Context, req resource.ImportStateRequest) (string, diag.Diagnostics) {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID, nil
+	}
+
+	var id types.String
+	diags := req.Identity.GetAttribute(ctx, path.Root("id"), &id)
+	return id.ValueString(), diags
+}
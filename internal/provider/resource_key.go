@@ -2,18 +2,26 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"net/url"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &KeyResource{}
 var _ resource.ResourceWithImportState = &KeyResource{}
+var _ resource.ResourceWithValidateConfig = &KeyResource{}
+var _ resource.ResourceWithIdentity = &KeyResource{}
 
 func NewKeyResource() resource.Resource {
 	return &KeyResource{}
@@ -24,52 +32,72 @@ type KeyResource struct {
 }
 
 type KeyResourceModel struct {
-	ID                       types.String  `tfsdk:"id"`
-	Key                      types.String  `tfsdk:"key"`
-	Models                   types.List    `tfsdk:"models"`
-	AllowedRoutes            types.List    `tfsdk:"allowed_routes"`
-	AllowedPassthroughRoutes types.List    `tfsdk:"allowed_passthrough_routes"`
-	MaxBudget                types.Float64 `tfsdk:"max_budget"`
-	UserID                   types.String  `tfsdk:"user_id"`
-	TeamID                   types.String  `tfsdk:"team_id"`
-	OrganizationID           types.String  `tfsdk:"organization_id"`
-	BudgetID                 types.String  `tfsdk:"budget_id"`
-	ServiceAccountID         types.String  `tfsdk:"service_account_id"`
-	MaxParallelRequests      types.Int64   `tfsdk:"max_parallel_requests"`
-	Metadata                 types.Map     `tfsdk:"metadata"`
-	TPMLimit                 types.Int64   `tfsdk:"tpm_limit"`
-	RPMLimit                 types.Int64   `tfsdk:"rpm_limit"`
-	TPMLimitType             types.String  `tfsdk:"tpm_limit_type"`
-	RPMLimitType             types.String  `tfsdk:"rpm_limit_type"`
-	BudgetDuration           types.String  `tfsdk:"budget_duration"`
-	AllowedCacheControls     types.List    `tfsdk:"allowed_cache_controls"`
-	SoftBudget               types.Float64 `tfsdk:"soft_budget"`
-	KeyAlias                 types.String  `tfsdk:"key_alias"`
-	Duration                 types.String  `tfsdk:"duration"`
-	Aliases                  types.Map     `tfsdk:"aliases"`
-	Config                   types.Map     `tfsdk:"config"`
-	Permissions              types.Map     `tfsdk:"permissions"`
-	ModelMaxBudget           types.Map     `tfsdk:"model_max_budget"`
-	ModelRPMLimit            types.Map     `tfsdk:"model_rpm_limit"`
-	ModelTPMLimit            types.Map     `tfsdk:"model_tpm_limit"`
-	Guardrails               types.List    `tfsdk:"guardrails"`
-	Prompts                  types.List    `tfsdk:"prompts"`
-	EnforcedParams           types.List    `tfsdk:"enforced_params"`
-	Tags                     types.List    `tfsdk:"tags"`
-	Blocked                  types.Bool    `tfsdk:"blocked"`
-	Spend                    types.Float64 `tfsdk:"spend"`
+	ID                        types.String           `tfsdk:"id"`
+	Key                       types.String           `tfsdk:"key"`
+	Token                     types.String           `tfsdk:"token"`
+	Models                    types.List             `tfsdk:"models"`
+	AllowedRoutes             types.List             `tfsdk:"allowed_routes"`
+	AllowedPassthroughRoutes  types.List             `tfsdk:"allowed_passthrough_routes"`
+	MaxBudget                 types.Float64          `tfsdk:"max_budget"`
+	UserID                    types.String           `tfsdk:"user_id"`
+	TeamID                    types.String           `tfsdk:"team_id"`
+	OrganizationID            types.String           `tfsdk:"organization_id"`
+	BudgetID                  types.String           `tfsdk:"budget_id"`
+	ServiceAccountID          types.String           `tfsdk:"service_account_id"`
+	MaxParallelRequests       types.Int64            `tfsdk:"max_parallel_requests"`
+	Metadata                  types.Map              `tfsdk:"metadata"`
+	TPMLimit                  types.Int64            `tfsdk:"tpm_limit"`
+	RPMLimit                  types.Int64            `tfsdk:"rpm_limit"`
+	TPMLimitType              types.String           `tfsdk:"tpm_limit_type"`
+	RPMLimitType              types.String           `tfsdk:"rpm_limit_type"`
+	BudgetDuration            types.String           `tfsdk:"budget_duration"`
+	AllowedCacheControls      types.List             `tfsdk:"allowed_cache_controls"`
+	SoftBudget                types.Float64          `tfsdk:"soft_budget"`
+	KeyAlias                  types.String           `tfsdk:"key_alias"`
+	Duration                  types.String           `tfsdk:"duration"`
+	Aliases                   types.Map              `tfsdk:"aliases"`
+	Config                    types.Map              `tfsdk:"config"`
+	Permissions               types.Map              `tfsdk:"permissions"`
+	ModelMaxBudget            types.Map              `tfsdk:"model_max_budget"`
+	ModelRPMLimit             types.Map              `tfsdk:"model_rpm_limit"`
+	ModelTPMLimit             types.Map              `tfsdk:"model_tpm_limit"`
+	Guardrails                types.List             `tfsdk:"guardrails"`
+	Prompts                   types.List             `tfsdk:"prompts"`
+	EnforcedParams            types.List             `tfsdk:"enforced_params"`
+	Tags                      types.List             `tfsdk:"tags"`
+	Blocked                   types.Bool             `tfsdk:"blocked"`
+	Spend                     types.Float64          `tfsdk:"spend"`
+	AutoRotate                types.Bool             `tfsdk:"auto_rotate"`
+	RotationInterval          types.String           `tfsdk:"rotation_interval"`
+	KeyType                   types.String           `tfsdk:"key_type"`
+	LastRotatedAt             types.String           `tfsdk:"last_rotated_at"`
+	NextRotationAt            types.String           `tfsdk:"next_rotation_at"`
+	AllowedVectorStoreIndexes types.List             `tfsdk:"allowed_vector_store_indexes"`
+	ObjectPermission          *ObjectPermissionModel `tfsdk:"object_permission"`
+	RouterSettings            *RouterSettingsModel   `tfsdk:"router_settings"`
 }
 
 func (r *KeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_key"
 }
 
+func (r *KeyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Hashed token of the key, as listed by /key/list, when it was created or imported. It is kept when auto_rotate replaces the key. The key itself is not used, as identities are not sensitive.")
+}
+
 func (r *KeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a LiteLLM API key.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "The unique identifier for this key (same as key value).",
+				Description: "The unique identifier for this key: the key value it was created or imported with. It is kept when auto_rotate replaces the key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: "Hashed token the proxy currently knows the key by. Changes when auto_rotate replaces the key.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -233,6 +261,37 @@ func (r *KeyResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				Description: "Amount spent by this key.",
 				Computed:    true,
 			},
+			"auto_rotate": schema.BoolAttribute{
+				Description: "Whether the proxy should automatically regenerate this key every rotation_interval. Requires key_alias so the key can be found again after rotation. The new secret is not returned to Terraform: once a rotation is detected, key is set to null and a warning is raised.",
+				Optional:    true,
+			},
+			"rotation_interval": schema.StringAttribute{
+				Description: "How often to rotate the key (e.g. 30d, 90d). Required when auto_rotate is true.",
+				Optional:    true,
+			},
+			"key_type": schema.StringAttribute{
+				Description: "Type of key, which determines its default allowed routes. Valid values: default, llm_api, management, read_only.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("default", "llm_api", "management", "read_only"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allowed_vector_store_indexes": allowedVectorStoreIndexesAttribute(),
+			"last_rotated_at": schema.StringAttribute{
+				Description: "Timestamp of the last automatic rotation.",
+				Computed:    true,
+			},
+			"next_rotation_at": schema.StringAttribute{
+				Description: "Timestamp of the next scheduled automatic rotation.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"object_permission": objectPermissionBlock(),
+			"router_settings":   routerSettingsBlock(),
 		},
 	}
 }
@@ -279,6 +338,7 @@ func (r *KeyResource) Create(ctx context.Context, req resource.CreateRequest, re
 		data.Key = types.StringValue(keyVal)
 		data.ID = types.StringValue(keyVal)
 	}
+	data.Token = types.StringValue(hashKeyToken(data.ID.ValueString()))
 
 	// Read back for full state
 	if err := r.readKey(ctx, &data); err != nil {
@@ -286,6 +346,7 @@ func (r *KeyResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, hashKeyToken(data.ID.ValueString()))...)
 }
 
 func (r *KeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -296,6 +357,7 @@ func (r *KeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	previousToken := data.currentToken()
 	if err := r.readKey(ctx, &data); err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
@@ -305,7 +367,17 @@ func (r *KeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	if data.currentToken() != previousToken {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("key"),
+			"Key Rotated",
+			fmt.Sprintf("The proxy rotated key %q, which revoked the secret in state, so key is now null. "+
+				"Fetch the new secret from the LiteLLM UI or API and distribute it outside of Terraform.", data.KeyAlias.ValueString()),
+		)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, hashKeyToken(data.ID.ValueString()))...)
 }
 
 func (r *KeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -323,17 +395,38 @@ func (r *KeyResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	}
 
 	data.ID = state.ID
-	data.Key = state.Key
+	data.Token = state.Token
+	if !state.Key.IsNull() {
+		data.Key = state.Key
+	}
 
+	// The token follows the key across auto-rotations, while key is cleared
+	// once the value issued at creation has been rotated out.
 	updateReq := r.buildKeyRequest(ctx, &data)
-	updateReq["key"] = data.Key.ValueString()
+	updateReq["key"] = data.currentToken()
+
+	if data.AllowedVectorStoreIndexes.IsNull() && !state.AllowedVectorStoreIndexes.IsNull() {
+		updateReq["allowed_vector_store_indexes"] = []map[string]interface{}{}
+	}
+	if data.ObjectPermission == nil && state.ObjectPermission != nil {
+		updateReq["object_permission"] = clearedObjectPermission()
+	}
+	if data.RouterSettings == nil && state.RouterSettings != nil {
+		updateReq["router_settings"] = map[string]interface{}{}
+	}
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/key/update", updateReq, nil); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update key: %s", err))
 		return
 	}
 
+	// Read back for computed rotation timestamps
+	if err := r.readKey(ctx, &data); err != nil {
+		resp.Diagnostics.AddWarning("Read Error", fmt.Sprintf("Key updated but failed to read back: %s", err))
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, hashKeyToken(data.ID.ValueString()))...)
 }
 
 func (r *KeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -345,7 +438,7 @@ func (r *KeyResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	}
 
 	deleteReq := map[string]interface{}{
-		"keys": []string{data.Key.ValueString()},
+		"keys": []string{data.currentToken()},
 	}
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/key/delete", deleteReq, nil); err != nil {
@@ -357,8 +450,14 @@ func (r *KeyResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *KeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), req.ID)...)
+	id, diags := importID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), id)...)
 }
 
 func (r *KeyResource) buildKeyRequest(ctx context.Context, data *KeyResourceModel) map[string]interface{} {
@@ -433,6 +532,15 @@ func (r *KeyResource) buildKeyRequest(ctx context.Context, data *KeyResourceMode
 	if !data.Duration.IsNull() {
 		keyReq["duration"] = data.Duration.ValueString()
 	}
+	if !data.AutoRotate.IsNull() {
+		keyReq["auto_rotate"] = data.AutoRotate.ValueBool()
+	}
+	if !data.RotationInterval.IsNull() && data.RotationInterval.ValueString() != "" {
+		keyReq["rotation_interval"] = data.RotationInterval.ValueString()
+	}
+	if !data.KeyType.IsNull() && data.KeyType.ValueString() != "" {
+		keyReq["key_type"] = data.KeyType.ValueString()
+	}
 
 	if !data.AllowedCacheControls.IsNull() {
 		var cacheControls []string
@@ -524,21 +632,47 @@ func (r *KeyResource) buildKeyRequest(ctx context.Context, data *KeyResourceMode
 		}
 	}
 
+	if !data.AllowedVectorStoreIndexes.IsNull() {
+		keyReq["allowed_vector_store_indexes"] = buildAllowedVectorStoreIndexes(ctx, data.AllowedVectorStoreIndexes)
+	}
+
+	if data.ObjectPermission != nil {
+		keyReq["object_permission"] = buildObjectPermission(ctx, data.ObjectPermission)
+	}
+
+	if data.RouterSettings != nil {
+		keyReq["router_settings"] = buildRouterSettings(ctx, data.RouterSettings)
+	}
+
 	return keyReq
 }
 
 func (r *KeyResource) readKey(ctx context.Context, data *KeyResourceModel) error {
-	keyID := data.ID.ValueString()
-	if keyID == "" {
-		keyID = data.Key.ValueString()
-	}
+	keyID := data.currentToken()
 
 	endpoint := fmt.Sprintf("/key/info?key=%s", keyID)
 
 	var result map[string]interface{}
-	if err := r.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
+	err := r.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result)
+	if err != nil && IsNotFoundError(err) && data.AutoRotate.ValueBool() {
+		// An auto-rotated key gets a new token, so the one in state no longer
+		// resolves. Follow it by alias instead of dropping it from state.
+		rotatedID, findErr := r.findRotatedKey(ctx, data, keyID)
+		if findErr != nil || rotatedID == "" {
+			return err
+		}
+		// The secret in state was revoked by the rotation, and the proxy does
+		// not return the new one when the key is read. id and the identity
+		// keep the original value, as Terraform does not allow them to change.
+		keyID = rotatedID
+		data.Key = types.StringNull()
+		endpoint = fmt.Sprintf("/key/info?key=%s", rotatedID)
+		err = r.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result)
+	}
+	if err != nil {
 		return err
 	}
+	data.Token = types.StringValue(keyID)
 
 	// Update computed fields from response
 	if spend, ok := result["spend"].(float64); ok {
@@ -569,5 +703,105 @@ func (r *KeyResource) readKey(ctx context.Context, data *KeyResourceModel) error
 		data.BudgetID = types.StringValue(budgetID)
 	}
 
+	// Rotation fields are only returned in the nested info object
+	keyInfo := result
+	if info, ok := result["info"].(map[string]interface{}); ok {
+		keyInfo = info
+	}
+	if autoRotate, ok := keyInfo["auto_rotate"].(bool); ok && (autoRotate || !data.AutoRotate.IsNull()) {
+		data.AutoRotate = types.BoolValue(autoRotate)
+	}
+	if interval, ok := keyInfo["rotation_interval"].(string); ok && interval != "" {
+		data.RotationInterval = types.StringValue(interval)
+	}
+	if keyType, ok := keyInfo["key_type"].(string); ok && keyType != "" && !data.KeyType.IsNull() {
+		data.KeyType = types.StringValue(keyType)
+	}
+	data.LastRotatedAt = types.StringNull()
+	if lastRotation, ok := keyInfo["last_rotation_at"].(string); ok {
+		data.LastRotatedAt = types.StringValue(lastRotation)
+	}
+	data.NextRotationAt = types.StringNull()
+	if nextRotation, ok := keyInfo["key_rotation_at"].(string); ok {
+		data.NextRotationAt = types.StringValue(nextRotation)
+	}
+
+	data.AllowedVectorStoreIndexes = readAllowedVectorStoreIndexes(ctx, result, data.AllowedVectorStoreIndexes, "info")
+	data.ObjectPermission = readObjectPermission(ctx, findObjectPermission(result, "info"), data.ObjectPermission)
+	data.RouterSettings = readRouterSettings(ctx, findRouterSettings(result, "info"), data.RouterSettings)
+
 	return nil
 }
+
+// currentToken returns the hashed token the key is currently known by, which
+// differs from id once the key was rotated.
+func (m *KeyResourceModel) currentToken() string {
+	if !m.Token.IsNull() && !m.Token.IsUnknown() && m.Token.ValueString() != "" {
+		return m.Token.ValueString()
+	}
+	if m.ID.ValueString() != "" {
+		return hashKeyToken(m.ID.ValueString())
+	}
+	return hashKeyToken(m.Key.ValueString())
+}
+
+// findRotatedKey looks up the current token of a key that was rotated by the
+// proxy, using its alias. It returns an empty string if no single match exists.
+func (r *KeyResource) findRotatedKey(ctx context.Context, data *KeyResourceModel, oldID string) (string, error) {
+	if data.KeyAlias.IsNull() || data.KeyAlias.ValueString() == "" {
+		return "", nil
+	}
+
+	endpoint := fmt.Sprintf("/key/list?key_alias=%s&return_full_object=true", url.QueryEscape(data.KeyAlias.ValueString()))
+
+	var result map[string]interface{}
+	if err := r.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
+		return "", err
+	}
+
+	keys, _ := result["keys"].([]interface{})
+	var token string
+	for _, k := range keys {
+		keyMap, ok := k.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		t, _ := keyMap["token"].(string)
+		if t == "" || t == oldID {
+			continue
+		}
+		if token != "" {
+			return "", nil
+		}
+		token = t
+	}
+
+	return token, nil
+}
+
+func (r *KeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data KeyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AutoRotate.ValueBool() && data.RotationInterval.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rotation_interval"),
+			"Missing Rotation Interval",
+			"rotation_interval must be set when auto_rotate is true.",
+		)
+	}
+}
+
+// hashKeyToken returns the token the proxy stores for a key: the SHA-256 of
+// an sk- key, or the value itself when it already is a token.
+func hashKeyToken(key string) string {
+	if !strings.HasPrefix(key, "sk-") {
+		return key
+	}
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestKeyResourceReadAfterRotation(t *testing.T) {
+	ctx := context.Background()
+
+	const originalKey = "sk-original"
+	originalToken := hashKeyToken(originalKey)
+	const rotatedToken = "rotated-token"
+
+	var infoRequests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/key/info":
+			key := r.URL.Query().Get("key")
+			infoRequests = append(infoRequests, key)
+			if key != rotatedToken {
+				w.WriteHeader(http.StatusNotFound)
+				json.NewEncoder(w).Encode(map[string]interface{}{"detail": "key not found"})
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"key": key,
+				"info": map[string]interface{}{
+					"key_alias":         "ci",
+					"auto_rotate":       true,
+					"rotation_interval": "30d",
+					"last_rotation_at":  "2026-10-01T00:00:00Z",
+				},
+			})
+		case "/key/list":
+			if alias := r.URL.Query().Get("key_alias"); alias != "ci" {
+				t.Errorf("listed keys with alias %q, want %q", alias, "ci")
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"keys": []interface{}{map[string]interface{}{"token": rotatedToken, "key_alias": "ci"}},
+			})
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	r := &KeyResource{client: &Client{APIBase: server.URL, HTTPClient: server.Client()}}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identitySchemaResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	for name, value := range map[string]string{"id": originalKey, "key": originalKey, "key_alias": "ci", "rotation_interval": "30d"} {
+		if diags := state.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("setting %s: %v", name, diags)
+		}
+	}
+	if diags := state.SetAttribute(ctx, path.Root("auto_rotate"), true); diags.HasError() {
+		t.Fatalf("setting auto_rotate: %v", diags)
+	}
+
+	identity := &tfsdk.ResourceIdentity{Schema: identitySchemaResp.IdentitySchema, Raw: tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil)}
+	if diags := setResourceIdentity(ctx, identity, originalToken); diags.HasError() {
+		t.Fatalf("setting identity: %v", diags)
+	}
+
+	read := func(state tfsdk.State) (KeyResourceModel, string, diag.Diagnostics) {
+		t.Helper()
+
+		resp := resource.ReadResponse{
+			State:    tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()},
+			Identity: &tfsdk.ResourceIdentity{Schema: identity.Schema, Raw: identity.Raw.Copy()},
+		}
+		r.Read(ctx, resource.ReadRequest{State: state, Identity: identity}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("read failed: %v", resp.Diagnostics)
+		}
+		if resp.State.Raw.IsNull() {
+			t.Fatal("key was removed from state")
+		}
+
+		var data KeyResourceModel
+		if diags := resp.State.Get(ctx, &data); diags.HasError() {
+			t.Fatalf("reading state: %v", diags)
+		}
+		var identityID types.String
+		if diags := resp.Identity.GetAttribute(ctx, path.Root("id"), &identityID); diags.HasError() {
+			t.Fatalf("reading identity: %v", diags)
+		}
+		return data, identityID.ValueString(), resp.Diagnostics
+	}
+
+	// The first refresh after the rotation follows the key by alias.
+	data, identityID, diags := read(state)
+	if data.ID.ValueString() != originalKey {
+		t.Errorf("id = %q, want it kept as %q", data.ID.ValueString(), originalKey)
+	}
+	if identityID != originalToken {
+		t.Errorf("identity = %q, want it kept as %q", identityID, originalToken)
+	}
+	if data.Token.ValueString() != rotatedToken {
+		t.Errorf("token = %q, want %q", data.Token.ValueString(), rotatedToken)
+	}
+	if !data.Key.IsNull() {
+		t.Errorf("key = %q, want null after rotation", data.Key.ValueString())
+	}
+	if diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Key Rotated" {
+		t.Errorf("diagnostics = %v, want a single Key Rotated warning", diags)
+	}
+
+	// Later refreshes read the rotated token directly and keep the identity.
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("storing state: %v", diags)
+	}
+	infoRequests = nil
+	data, identityID, diags = read(state)
+	if identityID != originalToken {
+		t.Errorf("identity = %q after a second read, want %q", identityID, originalToken)
+	}
+	if data.Token.ValueString() != rotatedToken {
+		t.Errorf("token = %q after a second read, want %q", data.Token.ValueString(), rotatedToken)
+	}
+	if len(diags) != 0 {
+		t.Errorf("unexpected diagnostics on a second read: %v", diags)
+	}
+	if len(infoRequests) != 1 || infoRequests[0] != rotatedToken {
+		t.Errorf("key info requests = %v, want only %q", infoRequests, rotatedToken)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,11 +14,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &MCPServerResource{}
 var _ resource.ResourceWithImportState = &MCPServerResource{}
+var _ resource.ResourceWithValidateConfig = &MCPServerResource{}
+var _ resource.ResourceWithIdentity = &MCPServerResource{}
+
+// mcpOAuth2CredentialKeys are the credential keys managed by the oauth2 block.
+var mcpOAuth2CredentialKeys = map[string]bool{
+	"client_id":     true,
+	"client_secret": true,
+	"scopes":        true,
+	"audience":      true,
+	"grant_type":    true,
+	"use_pkce":      true,
+}
 
 func NewMCPServerResource() resource.Resource {
 	return &MCPServerResource{}
@@ -39,21 +53,32 @@ type MCPInfoModel struct {
 	MCPServerCostInfo *MCPServerCostInfoModel `tfsdk:"mcp_server_cost_info"`
 }
 
+type MCPOAuth2Model struct {
+	ClientID            types.String `tfsdk:"client_id"`
+	ClientSecret        types.String `tfsdk:"client_secret"`
+	ClientSecretVersion types.Int64  `tfsdk:"client_secret_version"`
+	Scopes              types.List   `tfsdk:"scopes"`
+	Audience            types.String `tfsdk:"audience"`
+	GrantType           types.String `tfsdk:"grant_type"`
+	UsePKCE             types.Bool   `tfsdk:"use_pkce"`
+}
+
 type MCPServerResourceModel struct {
-	ID              types.String  `tfsdk:"id"`
-	ServerID        types.String  `tfsdk:"server_id"`
-	ServerName      types.String  `tfsdk:"server_name"`
-	Alias           types.String  `tfsdk:"alias"`
-	Description     types.String  `tfsdk:"description"`
-	URL             types.String  `tfsdk:"url"`
-	Transport       types.String  `tfsdk:"transport"`
-	SpecVersion     types.String  `tfsdk:"spec_version"`
-	AuthType        types.String  `tfsdk:"auth_type"`
-	MCPAccessGroups types.List    `tfsdk:"mcp_access_groups"`
-	Command         types.String  `tfsdk:"command"`
-	Args            types.List    `tfsdk:"args"`
-	Env             types.Map     `tfsdk:"env"`
-	MCPInfo         *MCPInfoModel `tfsdk:"mcp_info"`
+	ID              types.String    `tfsdk:"id"`
+	ServerID        types.String    `tfsdk:"server_id"`
+	ServerName      types.String    `tfsdk:"server_name"`
+	Alias           types.String    `tfsdk:"alias"`
+	Description     types.String    `tfsdk:"description"`
+	URL             types.String    `tfsdk:"url"`
+	Transport       types.String    `tfsdk:"transport"`
+	SpecVersion     types.String    `tfsdk:"spec_version"`
+	AuthType        types.String    `tfsdk:"auth_type"`
+	MCPAccessGroups types.List      `tfsdk:"mcp_access_groups"`
+	Command         types.String    `tfsdk:"command"`
+	Args            types.List      `tfsdk:"args"`
+	Env             types.Map       `tfsdk:"env"`
+	MCPInfo         *MCPInfoModel   `tfsdk:"mcp_info"`
+	OAuth2          *MCPOAuth2Model `tfsdk:"oauth2"`
 	// New fields for expanded API support
 	Credentials      types.Map    `tfsdk:"credentials"`
 	AllowedTools     types.List   `tfsdk:"allowed_tools"`
@@ -63,6 +88,7 @@ type MCPServerResourceModel struct {
 	TokenURL         types.String `tfsdk:"token_url"`
 	RegistrationURL  types.String `tfsdk:"registration_url"`
 	AllowAllKeys     types.Bool   `tfsdk:"allow_all_keys"`
+	VerifyOnApply    types.Bool   `tfsdk:"verify_on_apply"`
 	// Computed fields
 	CreatedAt        types.String `tfsdk:"created_at"`
 	CreatedBy        types.String `tfsdk:"created_by"`
@@ -77,6 +103,10 @@ func (r *MCPServerResource) Metadata(ctx context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_mcp_server"
 }
 
+func (r *MCPServerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("ID of the MCP server.")
+}
+
 func (r *MCPServerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a LiteLLM MCP (Model Context Protocol) server.",
@@ -125,12 +155,12 @@ func (r *MCPServerResource) Schema(ctx context.Context, req resource.SchemaReque
 				Default:     stringdefault.StaticString("2024-11-05"),
 			},
 			"auth_type": schema.StringAttribute{
-				Description: "Authentication type (none, bearer, basic).",
+				Description: "Authentication type (none, bearer, basic, oauth2). oauth2 requires the oauth2 block.",
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("none"),
 				Validators: []validator.String{
-					stringvalidator.OneOf("none", "bearer", "basic"),
+					stringvalidator.OneOf("none", "bearer", "basic", "oauth2"),
 				},
 			},
 			"mcp_access_groups": schema.ListAttribute{
@@ -189,6 +219,10 @@ func (r *MCPServerResource) Schema(ctx context.Context, req resource.SchemaReque
 				Description: "Whether to allow all API keys to access this MCP server.",
 				Optional:    true,
 			},
+			"verify_on_apply": schema.BoolAttribute{
+				Description: "Test the connection to the MCP server after create and update, failing the apply if it cannot be reached.",
+				Optional:    true,
+			},
 			"created_at": schema.StringAttribute{
 				Description: "Timestamp when the server was created.",
 				Computed:    true,
@@ -219,6 +253,45 @@ func (r *MCPServerResource) Schema(ctx context.Context, req resource.SchemaReque
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"oauth2": schema.SingleNestedBlock{
+				Description: "OAuth 2.0 client configuration used by the proxy to authenticate to the MCP server. Requires auth_type = \"oauth2\".",
+				Attributes: map[string]schema.Attribute{
+					"client_id": schema.StringAttribute{
+						Description: "OAuth client ID.",
+						Optional:    true,
+					},
+					"client_secret": schema.StringAttribute{
+						Description: "OAuth client secret. Write-only: it is sent to the proxy but never stored in state.",
+						Optional:    true,
+						Sensitive:   true,
+						WriteOnly:   true,
+					},
+					"client_secret_version": schema.Int64Attribute{
+						Description: "Version of client_secret. Change this value to push a rotated secret to the proxy.",
+						Optional:    true,
+					},
+					"scopes": schema.ListAttribute{
+						Description: "OAuth scopes to request.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"audience": schema.StringAttribute{
+						Description: "Audience to request the token for.",
+						Optional:    true,
+					},
+					"grant_type": schema.StringAttribute{
+						Description: "OAuth grant type (authorization_code, client_credentials).",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("authorization_code", "client_credentials"),
+						},
+					},
+					"use_pkce": schema.BoolAttribute{
+						Description: "Whether to use PKCE for the authorization code flow.",
+						Optional:    true,
+					},
+				},
+			},
 			"mcp_info": schema.SingleNestedBlock{
 				Description: "MCP server information and configuration.",
 				Attributes: map[string]schema.Attribute{
@@ -282,6 +355,10 @@ func (r *MCPServerResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	mcpReq := r.buildMCPServerRequest(ctx, &data)
+	resp.Diagnostics.Append(r.setOAuth2ClientSecret(ctx, req.Config, mcpReq)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/v1/mcp/server", mcpReq, &result); err != nil {
@@ -301,6 +378,13 @@ func (r *MCPServerResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
+
+	if data.VerifyOnApply.ValueBool() {
+		if err := r.client.VerifyConnection(ctx, "/mcp-rest/test/connection", mcpReq); err != nil {
+			resp.Diagnostics.AddError("Connection Test Failed", fmt.Sprintf("MCP server created but the connection test failed: %s", err))
+		}
+	}
 }
 
 func (r *MCPServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -321,6 +405,7 @@ func (r *MCPServerResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
 }
 
 func (r *MCPServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -343,6 +428,10 @@ func (r *MCPServerResource) Update(ctx context.Context, req resource.UpdateReque
 
 	mcpReq := r.buildMCPServerRequest(ctx, &data)
 	mcpReq["server_id"] = data.ServerID.ValueString()
+	resp.Diagnostics.Append(r.setOAuth2ClientSecret(ctx, req.Config, mcpReq)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	if err := r.client.DoRequestWithResponse(ctx, "PUT", "/v1/mcp/server", mcpReq, nil); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update MCP server: %s", err))
@@ -355,6 +444,13 @@ func (r *MCPServerResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
+
+	if data.VerifyOnApply.ValueBool() {
+		if err := r.client.VerifyConnection(ctx, "/mcp-rest/test/connection", mcpReq); err != nil {
+			resp.Diagnostics.AddError("Connection Test Failed", fmt.Sprintf("MCP server updated but the connection test failed: %s", err))
+		}
+	}
 }
 
 func (r *MCPServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -380,8 +476,73 @@ func (r *MCPServerResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *MCPServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), req.ID)...)
+	id, diags := importID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), id)...)
+}
+
+func (r *MCPServerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data MCPServerResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AuthType.IsUnknown() {
+		return
+	}
+
+	if data.AuthType.ValueString() == "oauth2" {
+		if data.OAuth2 == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("oauth2"),
+				"Missing OAuth2 Configuration",
+				"The oauth2 block is required when auth_type is \"oauth2\".",
+			)
+			return
+		}
+		if data.OAuth2.ClientID.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("oauth2").AtName("client_id"),
+				"Missing OAuth2 Client ID",
+				"client_id is required when auth_type is \"oauth2\".",
+			)
+		}
+		return
+	}
+
+	if data.OAuth2 != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_type"),
+			"Invalid Auth Type",
+			"auth_type must be \"oauth2\" when the oauth2 block is set.",
+		)
+	}
+}
+
+// setOAuth2ClientSecret adds the write-only client secret, which is only
+// available in the configuration, to the request credentials.
+func (r *MCPServerResource) setOAuth2ClientSecret(ctx context.Context, config tfsdk.Config, mcpReq map[string]interface{}) diag.Diagnostics {
+	var clientSecret types.String
+	diags := config.GetAttribute(ctx, path.Root("oauth2").AtName("client_secret"), &clientSecret)
+	if diags.HasError() || clientSecret.IsNull() || clientSecret.IsUnknown() {
+		return diags
+	}
+
+	credentials, ok := mcpReq["credentials"].(map[string]interface{})
+	if !ok {
+		credentials = map[string]interface{}{}
+	}
+	credentials["client_secret"] = clientSecret.ValueString()
+	mcpReq["credentials"] = credentials
+
+	return diags
 }
 
 func (r *MCPServerResource) buildMCPServerRequest(ctx context.Context, data *MCPServerResourceModel) map[string]interface{} {
@@ -422,9 +583,36 @@ func (r *MCPServerResource) buildMCPServerRequest(ctx context.Context, data *MCP
 	}
 
 	// New fields
-	if !data.Credentials.IsNull() {
-		var credentials map[string]string
-		data.Credentials.ElementsAs(ctx, &credentials, false)
+	if !data.Credentials.IsNull() || data.OAuth2 != nil {
+		credentials := map[string]interface{}{}
+		if !data.Credentials.IsNull() {
+			var credValues map[string]string
+			data.Credentials.ElementsAs(ctx, &credValues, false)
+			for k, v := range credValues {
+				credentials[k] = v
+			}
+		}
+
+		if data.OAuth2 != nil {
+			if !data.OAuth2.ClientID.IsNull() {
+				credentials["client_id"] = data.OAuth2.ClientID.ValueString()
+			}
+			if !data.OAuth2.Scopes.IsNull() {
+				var scopes []string
+				data.OAuth2.Scopes.ElementsAs(ctx, &scopes, false)
+				credentials["scopes"] = scopes
+			}
+			if !data.OAuth2.Audience.IsNull() {
+				credentials["audience"] = data.OAuth2.Audience.ValueString()
+			}
+			if !data.OAuth2.GrantType.IsNull() {
+				credentials["grant_type"] = data.OAuth2.GrantType.ValueString()
+			}
+			if !data.OAuth2.UsePKCE.IsNull() {
+				credentials["use_pkce"] = data.OAuth2.UsePKCE.ValueBool()
+			}
+		}
+
 		mcpReq["credentials"] = credentials
 	}
 
@@ -600,13 +788,51 @@ func (r *MCPServerResource) readMCPServer(ctx context.Context, data *MCPServerRe
 
 	// Handle new fields
 	if credentials, ok := result["credentials"].(map[string]interface{}); ok {
+		// An imported oauth2 server has no block in state yet, so its OAuth
+		// credentials are read into a new one rather than into credentials.
+		imported := false
+		if data.OAuth2 == nil && data.AuthType.ValueString() == "oauth2" {
+			data.OAuth2 = &MCPOAuth2Model{Scopes: types.ListNull(types.StringType)}
+			imported = true
+		}
+
 		credMap := make(map[string]attr.Value)
 		for k, v := range credentials {
+			// OAuth2 fields are tracked in the oauth2 block, not the credentials map
+			if data.OAuth2 != nil && mcpOAuth2CredentialKeys[k] {
+				continue
+			}
 			if str, ok := v.(string); ok {
 				credMap[k] = types.StringValue(str)
 			}
 		}
-		data.Credentials, _ = types.MapValue(types.StringType, credMap)
+		if data.OAuth2 == nil || len(credMap) > 0 || !data.Credentials.IsNull() {
+			data.Credentials, _ = types.MapValue(types.StringType, credMap)
+		}
+
+		if data.OAuth2 != nil {
+			if clientID, ok := credentials["client_id"].(string); ok {
+				data.OAuth2.ClientID = types.StringValue(clientID)
+			}
+			if scopes, ok := credentials["scopes"].([]interface{}); ok && (imported || !data.OAuth2.Scopes.IsNull()) {
+				scopeList := make([]attr.Value, 0, len(scopes))
+				for _, sc := range scopes {
+					if str, ok := sc.(string); ok {
+						scopeList = append(scopeList, types.StringValue(str))
+					}
+				}
+				data.OAuth2.Scopes, _ = types.ListValue(types.StringType, scopeList)
+			}
+			if audience, ok := credentials["audience"].(string); ok && (imported || !data.OAuth2.Audience.IsNull()) {
+				data.OAuth2.Audience = types.StringValue(audience)
+			}
+			if grantType, ok := credentials["grant_type"].(string); ok && (imported || !data.OAuth2.GrantType.IsNull()) {
+				data.OAuth2.GrantType = types.StringValue(grantType)
+			}
+			if usePKCE, ok := credentials["use_pkce"].(bool); ok && (imported || !data.OAuth2.UsePKCE.IsNull()) {
+				data.OAuth2.UsePKCE = types.BoolValue(usePKCE)
+			}
+		}
 	}
 
 	if allowedTools, ok := result["allowed_tools"].([]interface{}); ok {
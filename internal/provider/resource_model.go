@@ -2,10 +2,17 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,12 +21,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ModelResource{}
 var _ resource.ResourceWithImportState = &ModelResource{}
+var _ resource.ResourceWithValidateConfig = &ModelResource{}
+var _ resource.ResourceWithUpgradeState = &ModelResource{}
+var _ resource.ResourceWithConfigValidators = &ModelResource{}
+var _ resource.ResourceWithModifyPlan = &ModelResource{}
+var _ resource.ResourceWithIdentity = &ModelResource{}
 
 func NewModelResource() resource.Resource {
 	return &ModelResource{}
@@ -33,6 +47,8 @@ type ModelResource struct {
 // ModelResourceModel describes the resource data model.
 type ModelResourceModel struct {
 	ID                             types.String  `tfsdk:"id"`
+	ModelID                        types.String  `tfsdk:"model_id"`
+	OnConflict                     types.String  `tfsdk:"on_conflict"`
 	ModelName                      types.String  `tfsdk:"model_name"`
 	CustomLLMProvider              types.String  `tfsdk:"custom_llm_provider"`
 	TPM                            types.Int64   `tfsdk:"tpm"`
@@ -51,6 +67,15 @@ type ModelResourceModel struct {
 	LiteLLMCredentialName          types.String  `tfsdk:"litellm_credential_name"`
 	InputCostPerMillionTokens      types.Float64 `tfsdk:"input_cost_per_million_tokens"`
 	OutputCostPerMillionTokens     types.Float64 `tfsdk:"output_cost_per_million_tokens"`
+	MaxTokens                      types.Int64   `tfsdk:"max_tokens"`
+	MaxInputTokens                 types.Int64   `tfsdk:"max_input_tokens"`
+	MaxOutputTokens                types.Int64   `tfsdk:"max_output_tokens"`
+	SupportsVision                 types.Bool    `tfsdk:"supports_vision"`
+	SupportsFunctionCalling        types.Bool    `tfsdk:"supports_function_calling"`
+	SupportsReasoning              types.Bool    `tfsdk:"supports_reasoning"`
+	SupportsPromptCaching          types.Bool    `tfsdk:"supports_prompt_caching"`
+	CacheReadInputTokenCost        types.Float64 `tfsdk:"cache_read_input_token_cost"`
+	CacheCreationInputTokenCost    types.Float64 `tfsdk:"cache_creation_input_token_cost"`
 	InputCostPerPixel              types.Float64 `tfsdk:"input_cost_per_pixel"`
 	OutputCostPerPixel             types.Float64 `tfsdk:"output_cost_per_pixel"`
 	InputCostPerSecond             types.Float64 `tfsdk:"input_cost_per_second"`
@@ -64,16 +89,35 @@ type ModelResourceModel struct {
 	VertexLocation                 types.String  `tfsdk:"vertex_location"`
 	VertexCredentials              types.String  `tfsdk:"vertex_credentials"`
 	AccessGroups                   types.List    `tfsdk:"access_groups"`
-	AdditionalLiteLLMParams        types.Map     `tfsdk:"additional_litellm_params"`
+	AdditionalLiteLLMParams        types.Dynamic `tfsdk:"additional_litellm_params"`
+	LastHealthStatus               types.String  `tfsdk:"last_health_status"`
+
+	Azure     *ModelAzureModel     `tfsdk:"azure"`
+	Bedrock   *ModelBedrockModel   `tfsdk:"bedrock"`
+	Vertex    *ModelVertexModel    `tfsdk:"vertex"`
+	OpenAI    *ModelOpenAIModel    `tfsdk:"openai"`
+	Anthropic *ModelAnthropicModel `tfsdk:"anthropic"`
+	Ollama    *ModelOllamaModel    `tfsdk:"ollama"`
+	Watsonx   *ModelWatsonxModel   `tfsdk:"watsonx"`
+
+	HealthCheck *ModelHealthCheckSettingsModel `tfsdk:"health_check"`
 }
 
 func (r *ModelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_model"
 }
 
+func (r *ModelResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("ID of the model deployment.")
+}
+
 func (r *ModelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	blocks := modelProviderBlocksSchema()
+	blocks["health_check"] = modelHealthCheckBlockSchema()
+
 	resp.Schema = schema.Schema{
 		Description: "Manages a LiteLLM model deployment.",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The unique identifier for this model.",
@@ -82,6 +126,22 @@ func (r *ModelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"model_id": schema.StringAttribute{
+				Description: "ID of the deployment. Generated when unset. Setting it, e.g. with uuidv5(), makes a retried apply find the deployment an earlier failed apply created instead of creating a duplicate.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"on_conflict": schema.StringAttribute{
+				Description: "What create does when the deployment already exists, either with model_id or, when model_id is unset, with the same model_name, custom_llm_provider and base_model: adopt takes it over and updates it to match the configuration, error fails. When unset, only an existing model_id fails the create.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("adopt", "error"),
+				},
+			},
 			"model_name": schema.StringAttribute{
 				Description: "The name of the model as it will appear in LiteLLM.",
 				Required:    true,
@@ -154,11 +214,52 @@ func (r *ModelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:    true,
 			},
 			"input_cost_per_million_tokens": schema.Float64Attribute{
-				Description: "Input cost per million tokens.",
+				Description: "Input cost per million tokens. When unset and the provider's validate_model_cost_map is enabled, the base model's cost from LiteLLM's model cost map.",
 				Optional:    true,
+				Computed:    true,
 			},
 			"output_cost_per_million_tokens": schema.Float64Attribute{
-				Description: "Output cost per million tokens.",
+				Description: "Output cost per million tokens. When unset and the provider's validate_model_cost_map is enabled, the base model's cost from LiteLLM's model cost map.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"max_tokens": schema.Int64Attribute{
+				Description: "Maximum number of tokens the model handles. When unset and the provider's validate_model_cost_map is enabled, the base model's limit from LiteLLM's model cost map.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"max_input_tokens": schema.Int64Attribute{
+				Description: "Maximum number of input tokens. When unset and the provider's validate_model_cost_map is enabled, the base model's limit from LiteLLM's model cost map.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"max_output_tokens": schema.Int64Attribute{
+				Description: "Maximum number of output tokens. When unset and the provider's validate_model_cost_map is enabled, the base model's limit from LiteLLM's model cost map.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"supports_vision": schema.BoolAttribute{
+				Description: "Whether the model accepts image input. Used by the proxy to route vision requests.",
+				Optional:    true,
+			},
+			"supports_function_calling": schema.BoolAttribute{
+				Description: "Whether the model supports function calling.",
+				Optional:    true,
+			},
+			"supports_reasoning": schema.BoolAttribute{
+				Description: "Whether the model supports reasoning.",
+				Optional:    true,
+			},
+			"supports_prompt_caching": schema.BoolAttribute{
+				Description: "Whether the model supports prompt caching.",
+				Optional:    true,
+			},
+			"cache_read_input_token_cost": schema.Float64Attribute{
+				Description: "Cost per input token read from the prompt cache.",
+				Optional:    true,
+			},
+			"cache_creation_input_token_cost": schema.Float64Attribute{
+				Description: "Cost per input token written to the prompt cache.",
 				Optional:    true,
 			},
 			"input_cost_per_pixel": schema.Float64Attribute{
@@ -178,57 +279,362 @@ func (r *ModelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:    true,
 			},
 			"aws_access_key_id": schema.StringAttribute{
-				Description: "AWS access key ID for Bedrock.",
-				Optional:    true,
-				Sensitive:   true,
+				Description:        "AWS access key ID for Bedrock.",
+				Optional:           true,
+				Sensitive:          true,
+				DeprecationMessage: "Use aws_access_key_id in the bedrock block instead.",
 			},
 			"aws_secret_access_key": schema.StringAttribute{
-				Description: "AWS secret access key for Bedrock.",
-				Optional:    true,
-				Sensitive:   true,
+				Description:        "AWS secret access key for Bedrock.",
+				Optional:           true,
+				Sensitive:          true,
+				DeprecationMessage: "Use aws_secret_access_key in the bedrock block instead.",
 			},
 			"aws_region_name": schema.StringAttribute{
-				Description: "AWS region name for Bedrock.",
-				Optional:    true,
+				Description:        "AWS region name for Bedrock.",
+				Optional:           true,
+				DeprecationMessage: "Use aws_region_name in the bedrock block instead.",
 			},
 			"aws_session_name": schema.StringAttribute{
-				Description: "AWS session name for Bedrock.",
-				Optional:    true,
-				Sensitive:   true,
+				Description:        "AWS session name for Bedrock.",
+				Optional:           true,
+				Sensitive:          true,
+				DeprecationMessage: "Use aws_session_name in the bedrock block instead.",
 			},
 			"aws_role_name": schema.StringAttribute{
-				Description: "AWS role name for Bedrock.",
-				Optional:    true,
-				Sensitive:   true,
+				Description:        "AWS role name for Bedrock.",
+				Optional:           true,
+				Sensitive:          true,
+				DeprecationMessage: "Use aws_role_name in the bedrock block instead.",
 			},
 			"vertex_project": schema.StringAttribute{
-				Description: "Google Cloud project for Vertex AI.",
-				Optional:    true,
-				Sensitive:   true,
+				Description:        "Google Cloud project for Vertex AI.",
+				Optional:           true,
+				Sensitive:          true,
+				DeprecationMessage: "Use vertex_project in the vertex block instead.",
 			},
 			"vertex_location": schema.StringAttribute{
-				Description: "Google Cloud location for Vertex AI.",
-				Optional:    true,
-				Sensitive:   true,
+				Description:        "Google Cloud location for Vertex AI.",
+				Optional:           true,
+				Sensitive:          true,
+				DeprecationMessage: "Use vertex_location in the vertex block instead.",
 			},
 			"vertex_credentials": schema.StringAttribute{
-				Description: "Google Cloud credentials for Vertex AI.",
-				Optional:    true,
+				Description:        "Google Cloud credentials for Vertex AI.",
+				Optional:           true,
+				DeprecationMessage: "Use vertex_credentials in the vertex block instead.",
 			},
 			"access_groups": schema.ListAttribute{
 				Description: "List of access groups this model belongs to. Teams and keys with access to these groups can use this model.",
 				Optional:    true,
 				ElementType: types.StringType,
 			},
-			"additional_litellm_params": schema.MapAttribute{
-				Description: "Additional parameters to pass to litellm_params.",
+			"additional_litellm_params": schema.DynamicAttribute{
+				Description: "Object of additional parameters merged into litellm_params, e.g. { max_retries = 3, extra_headers = { ... } }. Values keep their type, so numbers, booleans and nested objects are sent as such. Keys managed by other attributes of this resource are rejected.",
 				Optional:    true,
-				ElementType: types.StringType,
 			},
+			"last_health_status": schema.StringAttribute{
+				Description: "Result of the last health check run by the health_check block: healthy, unhealthy or unknown when the check could not complete. Null when health checks are disabled.",
+				Computed:    true,
+			},
+		},
+		Blocks: blocks,
+	}
+}
+
+func (r *ModelResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	blocks := make([]path.Expression, 0, len(modelProviderBlocks))
+	for _, block := range modelProviderBlocks {
+		blocks = append(blocks, path.MatchRoot(block.name))
+	}
+
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(blocks...),
+		modelProviderBlockValidator{},
+	}
+}
+
+// modelManagedLiteLLMParams maps the litellm_params keys set from first-class
+// attributes to those attributes, so additional_litellm_params can't override them.
+var modelManagedLiteLLMParams = map[string]string{
+	"custom_llm_provider":                "custom_llm_provider",
+	"model":                              "base_model",
+	"input_cost_per_token":               "input_cost_per_million_tokens",
+	"output_cost_per_token":              "output_cost_per_million_tokens",
+	"tpm":                                "tpm",
+	"rpm":                                "rpm",
+	"api_key":                            "model_api_key",
+	"api_base":                           "model_api_base",
+	"api_version":                        "api_version",
+	"reasoning_effort":                   "reasoning_effort",
+	"merge_reasoning_content_in_choices": "merge_reasoning_content_in_choices",
+	"thinking":                           "thinking_enabled",
+	"aws_access_key_id":                  "aws_access_key_id",
+	"aws_secret_access_key":              "aws_secret_access_key",
+	"aws_region_name":                    "aws_region_name",
+	"aws_session_name":                   "aws_session_name",
+	"aws_role_name":                      "aws_role_name",
+	"vertex_project":                     "vertex_project",
+	"vertex_location":                    "vertex_location",
+	"vertex_credentials":                 "vertex_credentials",
+	"litellm_credential_name":            "litellm_credential_name",
+	"input_cost_per_pixel":               "input_cost_per_pixel",
+	"output_cost_per_pixel":              "output_cost_per_pixel",
+	"input_cost_per_second":              "input_cost_per_second",
+	"output_cost_per_second":             "output_cost_per_second",
+}
+
+func (r *ModelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var additional types.Dynamic
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("additional_litellm_params"), &additional)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if additional.IsNull() || additional.IsUnknown() || additional.IsUnderlyingValueNull() || additional.IsUnderlyingValueUnknown() {
+		return
+	}
+
+	elements, ok := dynamicObjectElements(additional)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("additional_litellm_params"),
+			"Invalid Additional LiteLLM Params",
+			"additional_litellm_params must be an object, e.g. { max_retries = 3 }.",
+		)
+		return
+	}
+
+	keys := make([]string, 0, len(elements))
+	for key := range elements {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		attribute, managed := modelManagedLiteLLMParams[key]
+		if !managed {
+			attribute, managed = modelProviderParamAttribute(key)
+		}
+		if managed {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("additional_litellm_params"),
+				"Conflicting Additional LiteLLM Param",
+				fmt.Sprintf("%q is set from the %s attribute and can't be overridden in additional_litellm_params. Use %s instead.", key, attribute, attribute),
+			)
+		}
+	}
+}
+
+// modelCostMapDefaults lists the attributes that default to the base model's
+// entry in the model cost map, with the entry key each is read from.
+var modelCostMapDefaults = []struct {
+	attribute  string
+	key        string
+	perMillion bool
+}{
+	{"input_cost_per_million_tokens", "input_cost_per_token", true},
+	{"output_cost_per_million_tokens", "output_cost_per_token", true},
+	{"max_tokens", "max_tokens", false},
+	{"max_input_tokens", "max_input_tokens", false},
+	{"max_output_tokens", "max_output_tokens", false},
+}
+
+// ModifyPlan checks base_model against the model cost map when the provider's
+// validate_model_cost_map is enabled, and plans the defaults of unset costs and
+// token limits. They are null when validation is disabled.
+func (r *ModelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// last_health_status is only set by applies that run the health check
+	var healthCheck *ModelHealthCheckSettingsModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("health_check"), &healthCheck)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !healthCheck.enabled() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_health_status"), types.StringNull())...)
+	}
+
+	var provider, baseModel types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("custom_llm_provider"), &provider)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("base_model"), &baseModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var entry map[string]interface{}
+	useState := false
+	if r.client != nil && r.client.ValidateModelCostMap {
+		// Without a base model there is nothing to validate yet. The
+		// defaults stay unknown and are resolved by the apply.
+		if provider.IsUnknown() || baseModel.IsUnknown() {
+			return
+		}
+
+		costMap, err := r.client.ModelCostMap(ctx)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Unable to Validate Base Model",
+				fmt.Sprintf("Unable to read the model cost map, so base_model %q was not validated: %s", baseModel.ValueString(), err),
+			)
+			// Keep the defaults planned from an earlier run rather than
+			// showing them as removed.
+			useState = !req.State.Raw.IsNull()
+		} else if _, found, ok := lookupModelCost(costMap, provider.ValueString(), baseModel.ValueString()); ok {
+			entry = found
+		} else {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("base_model"),
+				"Unknown Base Model",
+				fmt.Sprintf("Neither %s/%s nor %s is in LiteLLM's model cost map. The proxy will track spend for this model at zero cost unless input_cost_per_million_tokens and output_cost_per_million_tokens are set.",
+					provider.ValueString(), baseModel.ValueString(), baseModel.ValueString()),
+			)
+		}
+	}
+
+	for _, d := range modelCostMapDefaults {
+		attrPath := path.Root(d.attribute)
+
+		if d.perMillion {
+			var configured, value types.Float64
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, attrPath, &configured)...)
+			if !configured.IsNull() {
+				continue
+			}
+			value = types.Float64Null()
+			if cost, ok := entry[d.key].(float64); ok {
+				value = types.Float64Value(costPerMillionTokens(cost))
+			} else if useState {
+				resp.Diagnostics.Append(req.State.GetAttribute(ctx, attrPath, &value)...)
+			}
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, attrPath, value)...)
+			continue
+		}
+
+		var configured, value types.Int64
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, attrPath, &configured)...)
+		if !configured.IsNull() {
+			continue
+		}
+		value = types.Int64Null()
+		if limit, ok := entry[d.key].(float64); ok {
+			value = types.Int64Value(int64(limit))
+		} else if useState {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, attrPath, &value)...)
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, attrPath, value)...)
+	}
+}
+
+// resolveCostMapDefaults sets the cost map defaults left unknown by ModifyPlan,
+// because custom_llm_provider or base_model was only known at apply time. They
+// are taken from the cost map like at plan time, or null when it can't be read.
+func (r *ModelResource) resolveCostMapDefaults(ctx context.Context, data *ModelResourceModel) {
+	float64Defaults := map[string]*types.Float64{
+		"input_cost_per_million_tokens":  &data.InputCostPerMillionTokens,
+		"output_cost_per_million_tokens": &data.OutputCostPerMillionTokens,
+	}
+	int64Defaults := map[string]*types.Int64{
+		"max_tokens":        &data.MaxTokens,
+		"max_input_tokens":  &data.MaxInputTokens,
+		"max_output_tokens": &data.MaxOutputTokens,
+	}
+
+	var entry map[string]interface{}
+	lookedUp := false
+	for _, d := range modelCostMapDefaults {
+		float64Value, int64Value := float64Defaults[d.attribute], int64Defaults[d.attribute]
+		if (d.perMillion && !float64Value.IsUnknown()) || (!d.perMillion && !int64Value.IsUnknown()) {
+			continue
+		}
+
+		if !lookedUp {
+			lookedUp = true
+			if r.client != nil && r.client.ValidateModelCostMap {
+				if costMap, err := r.client.ModelCostMap(ctx); err == nil {
+					_, entry, _ = lookupModelCost(costMap, data.CustomLLMProvider.ValueString(), data.BaseModel.ValueString())
+				}
+			}
+		}
+
+		if d.perMillion {
+			*float64Value = types.Float64Null()
+			if cost, ok := entry[d.key].(float64); ok {
+				*float64Value = types.Float64Value(costPerMillionTokens(cost))
+			}
+			continue
+		}
+		*int64Value = types.Int64Null()
+		if limit, ok := entry[d.key].(float64); ok {
+			*int64Value = types.Int64Value(int64(limit))
+		}
+	}
+}
+
+// withoutCostMapDefaults returns the planned data with the defaults taken
+// from the model cost map removed, so the proxy keeps following the map
+// rather than a copy of it.
+func withoutCostMapDefaults(config, data ModelResourceModel) ModelResourceModel {
+	if config.InputCostPerMillionTokens.IsNull() {
+		data.InputCostPerMillionTokens = types.Float64Null()
+	}
+	if config.OutputCostPerMillionTokens.IsNull() {
+		data.OutputCostPerMillionTokens = types.Float64Null()
+	}
+	if config.MaxTokens.IsNull() {
+		data.MaxTokens = types.Int64Null()
+	}
+	if config.MaxInputTokens.IsNull() {
+		data.MaxInputTokens = types.Int64Null()
+	}
+	if config.MaxOutputTokens.IsNull() {
+		data.MaxOutputTokens = types.Int64Null()
+	}
+	return data
+}
+
+func (r *ModelResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored additional_litellm_params as map(string)
+		0: {
+			StateUpgrader: upgradeModelStateV0,
 		},
 	}
 }
 
+// upgradeModelStateV0 rewrites additional_litellm_params from a map(string) to
+// the JSON encoding of a dynamic value, an object of strings.
+func upgradeModelStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(req.RawState.JSON, &raw); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Model State", fmt.Sprintf("Unable to parse prior state: %s", err))
+		return
+	}
+
+	if params, ok := raw["additional_litellm_params"].(map[string]interface{}); ok {
+		attrTypes := make(map[string]interface{}, len(params))
+		for key := range params {
+			attrTypes[key] = "string"
+		}
+		raw["additional_litellm_params"] = map[string]interface{}{
+			"value": params,
+			"type":  []interface{}{"object", attrTypes},
+		}
+	}
+
+	upgraded, err := json.Marshal(raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Model State", fmt.Sprintf("Unable to encode upgraded state: %s", err))
+		return
+	}
+
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+}
+
 func (r *ModelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -254,21 +660,60 @@ func (r *ModelResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	modelID := uuid.New().String()
+	var config ModelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.resolveCostMapDefaults(ctx, &data)
 
-	if err := r.createOrUpdateModel(ctx, &data, modelID, false); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create model: %s", err))
+	existingID, err := r.findExistingModel(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check for an existing model: %s", err))
 		return
 	}
 
-	data.ID = types.StringValue(modelID)
+	requestData := withoutCostMapDefaults(config, data)
+	switch {
+	case existingID != "" && data.OnConflict.ValueString() == "adopt":
+		requestData.ID = types.StringValue(existingID)
+		if err := r.patchModel(ctx, &requestData); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update adopted model %s: %s", existingID, err))
+			return
+		}
+		data.ID = types.StringValue(existingID)
+	case existingID != "":
+		resp.Diagnostics.AddError(
+			"Model Already Exists",
+			fmt.Sprintf("A model deployment with ID %s already exists. Import it with terraform import, or set on_conflict = \"adopt\" to take it over.", existingID),
+		)
+		return
+	default:
+		modelID := uuid.New().String()
+		if !data.ModelID.IsNull() && !data.ModelID.IsUnknown() {
+			modelID = data.ModelID.ValueString()
+		}
+		if err := r.createOrUpdateModel(ctx, &requestData, modelID, false); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create model: %s", err))
+			return
+		}
+		data.ID = types.StringValue(modelID)
+	}
+	data.ModelID = data.ID
 
-	// Read back to ensure consistency
+	// Read back to ensure consistency. Drift in additional_litellm_params is
+	// left to the next refresh, as the planned value must be applied as is.
+	additionalParams := data.AdditionalLiteLLMParams
 	if err := r.readModelWithRetry(ctx, &data, 5); err != nil {
 		resp.Diagnostics.AddWarning("Read Error", fmt.Sprintf("Model created but failed to read back: %s", err))
 	}
+	data.AdditionalLiteLLMParams = additionalParams
+
+	r.runHealthCheck(ctx, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
 }
 
 func (r *ModelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -290,6 +735,7 @@ func (r *ModelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
 }
 
 func (r *ModelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -306,15 +752,26 @@ func (r *ModelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	var config ModelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	data.ID = state.ID
+	r.resolveCostMapDefaults(ctx, &data)
 
 	// Use PATCH endpoint for partial updates
-	if err := r.patchModel(ctx, &data); err != nil {
+	requestData := withoutCostMapDefaults(config, data)
+	if err := r.patchModel(ctx, &requestData); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update model: %s", err))
 		return
 	}
 
+	r.runHealthCheck(ctx, &data, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
 }
 
 func (r *ModelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -334,13 +791,19 @@ func (r *ModelResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *ModelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// modelString is the litellm_params model sent for a deployment, the base
+// model prefixed with its provider.
+func modelString(customLLMProvider, baseModel string) string {
+	return fmt.Sprintf("%s/%s", customLLMProvider, baseModel)
 }
 
 func (r *ModelResource) createOrUpdateModel(ctx context.Context, data *ModelResourceModel, modelID string, isUpdate bool) error {
 	customLLMProvider := data.CustomLLMProvider.ValueString()
 	baseModel := data.BaseModel.ValueString()
-	modelName := fmt.Sprintf("%s/%s", customLLMProvider, baseModel)
+	modelName := modelString(customLLMProvider, baseModel)
 
 	litellmParams := map[string]interface{}{
 		"custom_llm_provider": customLLMProvider,
@@ -433,6 +896,12 @@ func (r *ModelResource) createOrUpdateModel(ctx context.Context, data *ModelReso
 		litellmParams["output_cost_per_second"] = data.OutputCostPerSecond.ValueFloat64()
 	}
 
+	addModelProviderParams(ctx, data, litellmParams)
+
+	if err := mergeAdditionalLiteLLMParams(data, litellmParams); err != nil {
+		return err
+	}
+
 	modelInfo := map[string]interface{}{
 		"id":         modelID,
 		"db_model":   true,
@@ -444,6 +913,33 @@ func (r *ModelResource) createOrUpdateModel(ctx context.Context, data *ModelReso
 	if !data.TeamID.IsNull() && data.TeamID.ValueString() != "" {
 		modelInfo["team_id"] = data.TeamID.ValueString()
 	}
+	if !data.MaxTokens.IsNull() {
+		modelInfo["max_tokens"] = data.MaxTokens.ValueInt64()
+	}
+	if !data.MaxInputTokens.IsNull() {
+		modelInfo["max_input_tokens"] = data.MaxInputTokens.ValueInt64()
+	}
+	if !data.MaxOutputTokens.IsNull() {
+		modelInfo["max_output_tokens"] = data.MaxOutputTokens.ValueInt64()
+	}
+	if !data.SupportsVision.IsNull() {
+		modelInfo["supports_vision"] = data.SupportsVision.ValueBool()
+	}
+	if !data.SupportsFunctionCalling.IsNull() {
+		modelInfo["supports_function_calling"] = data.SupportsFunctionCalling.ValueBool()
+	}
+	if !data.SupportsReasoning.IsNull() {
+		modelInfo["supports_reasoning"] = data.SupportsReasoning.ValueBool()
+	}
+	if !data.SupportsPromptCaching.IsNull() {
+		modelInfo["supports_prompt_caching"] = data.SupportsPromptCaching.ValueBool()
+	}
+	if !data.CacheReadInputTokenCost.IsNull() {
+		modelInfo["cache_read_input_token_cost"] = data.CacheReadInputTokenCost.ValueFloat64()
+	}
+	if !data.CacheCreationInputTokenCost.IsNull() {
+		modelInfo["cache_creation_input_token_cost"] = data.CacheCreationInputTokenCost.ValueFloat64()
+	}
 
 	// Add access_groups to model_info if specified
 	if !data.AccessGroups.IsNull() {
@@ -476,6 +972,8 @@ func (r *ModelResource) readModel(ctx context.Context, data *ModelResourceModel)
 		return err
 	}
 
+	data.ModelID = data.ID
+
 	// Update data from response while preserving sensitive values
 	if modelName, ok := result["model_name"].(string); ok && modelName != "" {
 		data.ModelName = types.StringValue(modelName)
@@ -497,12 +995,14 @@ func (r *ModelResource) readModel(ctx context.Context, data *ModelResourceModel)
 		if rpm, ok := litellmParams["rpm"].(float64); ok {
 			data.RPM = types.Int64Value(int64(rpm))
 		}
-		if awsRegion, ok := litellmParams["aws_region_name"].(string); ok && awsRegion != "" {
+		if awsRegion, ok := litellmParams["aws_region_name"].(string); ok && awsRegion != "" && data.Bedrock == nil {
 			data.AWSRegionName = types.StringValue(awsRegion)
 		}
 		if credName, ok := litellmParams["litellm_credential_name"].(string); ok && credName != "" {
 			data.LiteLLMCredentialName = types.StringValue(credName)
 		}
+		readModelProviderParams(ctx, data, litellmParams)
+		data.AdditionalLiteLLMParams = readAdditionalLiteLLMParams(ctx, data.AdditionalLiteLLMParams, litellmParams)
 	}
 
 	if modelInfo, ok := result["model_info"].(map[string]interface{}); ok {
@@ -518,6 +1018,7 @@ func (r *ModelResource) readModel(ctx context.Context, data *ModelResourceModel)
 		if teamID, ok := modelInfo["team_id"].(string); ok && teamID != "" {
 			data.TeamID = types.StringValue(teamID)
 		}
+		readModelInfoCapabilities(data, modelInfo)
 		// Read access_groups from model_info
 		if accessGroups, ok := modelInfo["access_groups"].([]interface{}); ok && len(accessGroups) > 0 {
 			groupStrings := make([]string, 0, len(accessGroups))
@@ -538,6 +1039,200 @@ func (r *ModelResource) readModel(ctx context.Context, data *ModelResourceModel)
 	return nil
 }
 
+// findExistingModel returns the ID of the deployment a create would duplicate,
+// or "" when there is none. A configured model_id is looked up directly. Without
+// one, and only when on_conflict is set, deployments are matched on model_name,
+// custom_llm_provider and base_model, as model_name alone is commonly shared by
+// load balanced deployments.
+func (r *ModelResource) findExistingModel(ctx context.Context, data *ModelResourceModel) (string, error) {
+	if !data.ModelID.IsNull() && !data.ModelID.IsUnknown() {
+		modelID := data.ModelID.ValueString()
+		var result map[string]interface{}
+		err := r.client.DoRequestWithResponse(ctx, "GET", "/model/info?litellm_model_id="+url.QueryEscape(modelID), nil, &result)
+		if err != nil {
+			if IsNotFoundError(err) {
+				return "", nil
+			}
+			return "", err
+		}
+		if len(result) == 0 {
+			return "", nil
+		}
+		return modelID, nil
+	}
+
+	if data.OnConflict.IsNull() {
+		return "", nil
+	}
+
+	models, err := r.client.ListAll(ctx, "/model/info", ListOptions{ItemsKeys: []string{"data", "models"}})
+	if err != nil {
+		return "", err
+	}
+
+	provider := data.CustomLLMProvider.ValueString()
+	baseModel := data.BaseModel.ValueString()
+	var matches []string
+	for _, m := range models {
+		modelMap, ok := m.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, _ := modelMap["model_name"].(string); name != data.ModelName.ValueString() {
+			continue
+		}
+		litellmParams, _ := modelMap["litellm_params"].(map[string]interface{})
+		modelInfo, _ := modelMap["model_info"].(map[string]interface{})
+		if p, _ := litellmParams["custom_llm_provider"].(string); p != provider {
+			continue
+		}
+		base, _ := modelInfo["base_model"].(string)
+		model, _ := litellmParams["model"].(string)
+		if base != baseModel && model != modelString(provider, baseModel) {
+			continue
+		}
+		if id, ok := modelInfo["id"].(string); ok && id != "" {
+			matches = append(matches, id)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", nil
+	case 1:
+		return matches[0], nil
+	}
+	sort.Strings(matches)
+	if data.OnConflict.ValueString() == "adopt" {
+		return "", fmt.Errorf("%d deployments match model_name %q, custom_llm_provider %q and base_model %q, so none can be adopted: %s. Set model_id to the one to adopt",
+			len(matches), data.ModelName.ValueString(), provider, baseModel, strings.Join(matches, ", "))
+	}
+	return matches[0], nil
+}
+
+// readModelInfoCapabilities refreshes the token limits, capabilities and cache
+// costs in model_info. The proxy fills these in from the model cost map for
+// every model, so only attributes that are already set are read, as the others
+// would otherwise show the cost map as drift.
+func readModelInfoCapabilities(data *ModelResourceModel, modelInfo map[string]interface{}) {
+	intFields := map[string]*types.Int64{
+		"max_tokens":        &data.MaxTokens,
+		"max_input_tokens":  &data.MaxInputTokens,
+		"max_output_tokens": &data.MaxOutputTokens,
+	}
+	for key, target := range intFields {
+		if v, ok := modelInfo[key].(float64); ok && !target.IsNull() {
+			*target = types.Int64Value(int64(v))
+		}
+	}
+
+	boolFields := map[string]*types.Bool{
+		"supports_vision":           &data.SupportsVision,
+		"supports_function_calling": &data.SupportsFunctionCalling,
+		"supports_reasoning":        &data.SupportsReasoning,
+		"supports_prompt_caching":   &data.SupportsPromptCaching,
+	}
+	for key, target := range boolFields {
+		if v, ok := modelInfo[key].(bool); ok && !target.IsNull() {
+			*target = types.BoolValue(v)
+		}
+	}
+
+	floatFields := map[string]*types.Float64{
+		"cache_read_input_token_cost":     &data.CacheReadInputTokenCost,
+		"cache_creation_input_token_cost": &data.CacheCreationInputTokenCost,
+	}
+	for key, target := range floatFields {
+		if v, ok := modelInfo[key].(float64); ok && !target.IsNull() {
+			*target = types.Float64Value(v)
+		}
+	}
+}
+
+// mergeAdditionalLiteLLMParams adds additional_litellm_params to litellmParams.
+// Keys managed by other attributes are rejected by ValidateConfig.
+func mergeAdditionalLiteLLMParams(data *ModelResourceModel, litellmParams map[string]interface{}) error {
+	additional, err := dynamicToInterface(data.AdditionalLiteLLMParams)
+	if err != nil {
+		return fmt.Errorf("invalid additional_litellm_params: %w", err)
+	}
+	if additional == nil {
+		return nil
+	}
+
+	params, ok := additional.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("additional_litellm_params must be an object")
+	}
+	for key, value := range params {
+		// Merge extra_headers with headers set by provider blocks
+		if existing, ok := litellmParams[key].(map[string]interface{}); ok {
+			if headers, ok := value.(map[string]interface{}); ok {
+				merged := make(map[string]interface{}, len(existing)+len(headers))
+				for k, v := range existing {
+					merged[k] = v
+				}
+				for k, v := range headers {
+					merged[k] = v
+				}
+				value = merged
+			}
+		}
+		litellmParams[key] = value
+	}
+
+	return nil
+}
+
+// readAdditionalLiteLLMParams refreshes the configured additional params from
+// the litellm_params returned by the API. Only configured keys are tracked.
+// Keys the API doesn't return, such as masked secrets, and values that encode
+// to the same JSON keep their configured form.
+func readAdditionalLiteLLMParams(ctx context.Context, current types.Dynamic, litellmParams map[string]interface{}) types.Dynamic {
+	elements, ok := dynamicObjectElements(current)
+	if !ok {
+		return current
+	}
+
+	changed := false
+	attrTypes := make(map[string]attr.Type, len(elements))
+	attrs := make(map[string]attr.Value, len(elements))
+	for key, value := range elements {
+		attrs[key] = value
+		if remote, found := litellmParams[key]; found {
+			configured, err := attrValueToInterface(value)
+			// Keys added to a configured object, e.g. headers set by a provider
+			// block in extra_headers, are not drift
+			configuredMap, configuredIsMap := configured.(map[string]interface{})
+			remoteMap, remoteIsMap := remote.(map[string]interface{})
+			if configuredIsMap && remoteIsMap {
+				tracked := make(map[string]interface{}, len(configuredMap))
+				for k := range configuredMap {
+					if v, ok := remoteMap[k]; ok {
+						tracked[k] = v
+					}
+				}
+				remote = tracked
+			}
+			if err == nil && !jsonEqual(configured, remote) {
+				attrs[key] = interfaceToAttrValue(ctx, remote)
+				changed = true
+			}
+		}
+		attrTypes[key] = attrs[key].Type(ctx)
+	}
+
+	if !changed {
+		return current
+	}
+
+	object, diags := types.ObjectValue(attrTypes, attrs)
+	if diags.HasError() {
+		return current
+	}
+	return types.DynamicValue(object)
+}
+
 func (r *ModelResource) readModelWithRetry(ctx context.Context, data *ModelResourceModel, maxRetries int) error {
 	var err error
 	delay := 1 * time.Second
@@ -570,7 +1265,7 @@ func (r *ModelResource) patchModel(ctx context.Context, data *ModelResourceModel
 	modelID := data.ID.ValueString()
 	customLLMProvider := data.CustomLLMProvider.ValueString()
 	baseModel := data.BaseModel.ValueString()
-	modelName := fmt.Sprintf("%s/%s", customLLMProvider, baseModel)
+	modelName := modelString(customLLMProvider, baseModel)
 
 	// Build litellm_params for the patch request
 	litellmParams := map[string]interface{}{
@@ -664,6 +1359,12 @@ func (r *ModelResource) patchModel(ctx context.Context, data *ModelResourceModel
 		litellmParams["output_cost_per_second"] = data.OutputCostPerSecond.ValueFloat64()
 	}
 
+	addModelProviderParams(ctx, data, litellmParams)
+
+	if err := mergeAdditionalLiteLLMParams(data, litellmParams); err != nil {
+		return err
+	}
+
 	// Build model_info for the PATCH request
 	modelInfo := map[string]interface{}{
 		"base_model": baseModel,
@@ -674,6 +1375,33 @@ func (r *ModelResource) patchModel(ctx context.Context, data *ModelResourceModel
 	if !data.TeamID.IsNull() && data.TeamID.ValueString() != "" {
 		modelInfo["team_id"] = data.TeamID.ValueString()
 	}
+	if !data.MaxTokens.IsNull() {
+		modelInfo["max_tokens"] = data.MaxTokens.ValueInt64()
+	}
+	if !data.MaxInputTokens.IsNull() {
+		modelInfo["max_input_tokens"] = data.MaxInputTokens.ValueInt64()
+	}
+	if !data.MaxOutputTokens.IsNull() {
+		modelInfo["max_output_tokens"] = data.MaxOutputTokens.ValueInt64()
+	}
+	if !data.SupportsVision.IsNull() {
+		modelInfo["supports_vision"] = data.SupportsVision.ValueBool()
+	}
+	if !data.SupportsFunctionCalling.IsNull() {
+		modelInfo["supports_function_calling"] = data.SupportsFunctionCalling.ValueBool()
+	}
+	if !data.SupportsReasoning.IsNull() {
+		modelInfo["supports_reasoning"] = data.SupportsReasoning.ValueBool()
+	}
+	if !data.SupportsPromptCaching.IsNull() {
+		modelInfo["supports_prompt_caching"] = data.SupportsPromptCaching.ValueBool()
+	}
+	if !data.CacheReadInputTokenCost.IsNull() {
+		modelInfo["cache_read_input_token_cost"] = data.CacheReadInputTokenCost.ValueFloat64()
+	}
+	if !data.CacheCreationInputTokenCost.IsNull() {
+		modelInfo["cache_creation_input_token_cost"] = data.CacheCreationInputTokenCost.ValueFloat64()
+	}
 
 	// Add access_groups to model_info if specified
 	if !data.AccessGroups.IsNull() {
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ModelGroupPublicResource{}
+var _ resource.ResourceWithImportState = &ModelGroupPublicResource{}
+
+func NewModelGroupPublicResource() resource.Resource {
+	return &ModelGroupPublicResource{}
+}
+
+// ModelGroupPublicResource manages the full set of model groups shown in the
+// public model hub. The proxy stores a single list, so only one instance of
+// this resource should exist per proxy.
+type ModelGroupPublicResource struct {
+	client *Client
+}
+
+type ModelGroupPublicResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ModelGroups types.Set    `tfsdk:"model_groups"`
+}
+
+func (r *ModelGroupPublicResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_group_public"
+}
+
+func (r *ModelGroupPublicResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages which model groups are visible in the LiteLLM public model hub. Only one instance of this resource should exist per proxy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"model_groups": schema.SetAttribute{
+				Description: "Model groups to make public. Groups not listed are hidden from the model hub.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *ModelGroupPublicResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ModelGroupPublicResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ModelGroupPublicResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setPublicModelGroups(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update public model groups: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("model_group_public")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ModelGroupPublicResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ModelGroupPublicResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result []interface{}
+	if err := r.client.DoRequestWithResponse(ctx, "GET", "/public/model_hub", nil, &result); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read public model groups: %s", err))
+		return
+	}
+
+	publicGroups := make([]string, 0, len(result))
+	for _, g := range result {
+		info, ok := g.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if isPublic, ok := info["is_public_model_group"].(bool); ok && !isPublic {
+			continue
+		}
+		if name, ok := info["model_group"].(string); ok {
+			publicGroups = append(publicGroups, name)
+		}
+	}
+
+	setValue, diags := types.SetValueFrom(ctx, types.StringType, publicGroups)
+	resp.Diagnostics.Append(diags...)
+	data.ModelGroups = setValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ModelGroupPublicResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ModelGroupPublicResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setPublicModelGroups(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update public model groups: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("model_group_public")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ModelGroupPublicResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	publicReq := map[string]interface{}{
+		"model_groups": []string{},
+	}
+
+	if err := r.client.DoRequestWithResponse(ctx, "POST", "/model_group/make_public", publicReq, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear public model groups: %s", err))
+		return
+	}
+}
+
+func (r *ModelGroupPublicResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *ModelGroupPublicResource) setPublicModelGroups(ctx context.Context, data *ModelGroupPublicResourceModel) error {
+	var modelGroups []string
+	data.ModelGroups.ElementsAs(ctx, &modelGroups, false)
+	if modelGroups == nil {
+		modelGroups = []string{}
+	}
+
+	publicReq := map[string]interface{}{
+		"model_groups": modelGroups,
+	}
+
+	return r.client.DoRequestWithResponse(ctx, "POST", "/model_group/make_public", publicReq, nil)
+}
@@ -15,6 +15,7 @@ import (
 
 var _ resource.Resource = &OrganizationResource{}
 var _ resource.ResourceWithImportState = &OrganizationResource{}
+var _ resource.ResourceWithIdentity = &OrganizationResource{}
 
 func NewOrganizationResource() resource.Resource {
 	return &OrganizationResource{}
@@ -25,29 +26,34 @@ type OrganizationResource struct {
 }
 
 type OrganizationResourceModel struct {
-	ID                types.String  `tfsdk:"id"`
-	OrganizationID    types.String  `tfsdk:"organization_id"`
-	OrganizationAlias types.String  `tfsdk:"organization_alias"`
-	Models            types.List    `tfsdk:"models"`
-	BudgetID          types.String  `tfsdk:"budget_id"`
-	MaxBudget         types.Float64 `tfsdk:"max_budget"`
-	TPMLimit          types.Int64   `tfsdk:"tpm_limit"`
-	RPMLimit          types.Int64   `tfsdk:"rpm_limit"`
-	ModelRPMLimit     types.Map     `tfsdk:"model_rpm_limit"`
-	ModelTPMLimit     types.Map     `tfsdk:"model_tpm_limit"`
-	BudgetDuration    types.String  `tfsdk:"budget_duration"`
-	Metadata          types.Map     `tfsdk:"metadata"`
-	Blocked           types.Bool    `tfsdk:"blocked"`
-	Tags              types.List    `tfsdk:"tags"`
-	Spend             types.Float64 `tfsdk:"spend"`
-	CreatedAt         types.String  `tfsdk:"created_at"`
-	UpdatedAt         types.String  `tfsdk:"updated_at"`
+	ID                types.String           `tfsdk:"id"`
+	OrganizationID    types.String           `tfsdk:"organization_id"`
+	OrganizationAlias types.String           `tfsdk:"organization_alias"`
+	Models            types.List             `tfsdk:"models"`
+	BudgetID          types.String           `tfsdk:"budget_id"`
+	MaxBudget         types.Float64          `tfsdk:"max_budget"`
+	TPMLimit          types.Int64            `tfsdk:"tpm_limit"`
+	RPMLimit          types.Int64            `tfsdk:"rpm_limit"`
+	ModelRPMLimit     types.Map              `tfsdk:"model_rpm_limit"`
+	ModelTPMLimit     types.Map              `tfsdk:"model_tpm_limit"`
+	BudgetDuration    types.String           `tfsdk:"budget_duration"`
+	Metadata          types.Map              `tfsdk:"metadata"`
+	Blocked           types.Bool             `tfsdk:"blocked"`
+	Tags              types.List             `tfsdk:"tags"`
+	Spend             types.Float64          `tfsdk:"spend"`
+	CreatedAt         types.String           `tfsdk:"created_at"`
+	UpdatedAt         types.String           `tfsdk:"updated_at"`
+	ObjectPermission  *ObjectPermissionModel `tfsdk:"object_permission"`
 }
 
 func (r *OrganizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_organization"
 }
 
+func (r *OrganizationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("ID of the organization.")
+}
+
 func (r *OrganizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a LiteLLM organization. Organizations can own teams and have org-level budgets and model access.",
@@ -135,6 +141,9 @@ func (r *OrganizationResource) Schema(ctx context.Context, req resource.SchemaRe
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"object_permission": objectPermissionBlock(),
+		},
 	}
 }
 
@@ -183,6 +192,7 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
 }
 
 func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -203,6 +213,7 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
 }
 
 func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -226,6 +237,10 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 	orgReq := r.buildOrganizationRequest(ctx, &data)
 	orgReq["organization_id"] = data.OrganizationID.ValueString()
 
+	if data.ObjectPermission == nil && state.ObjectPermission != nil {
+		orgReq["object_permission"] = clearedObjectPermission()
+	}
+
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/organization/update", orgReq, nil); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update organization: %s", err))
 		return
@@ -237,6 +252,7 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
 }
 
 func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -260,8 +276,14 @@ func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *OrganizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), req.ID)...)
+	id, diags := importID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), id)...)
 }
 
 func (r *OrganizationResource) buildOrganizationRequest(ctx context.Context, data *OrganizationResourceModel) map[string]interface{} {
@@ -322,6 +344,10 @@ func (r *OrganizationResource) buildOrganizationRequest(ctx context.Context, dat
 		orgReq["tags"] = tags
 	}
 
+	if data.ObjectPermission != nil {
+		orgReq["object_permission"] = buildObjectPermission(ctx, data.ObjectPermission)
+	}
+
 	return orgReq
 }
 
@@ -433,5 +459,7 @@ func (r *OrganizationResource) readOrganization(ctx context.Context, data *Organ
 		data.ModelTPMLimit, _ = types.MapValue(types.Int64Type, tpmMap)
 	}
 
+	data.ObjectPermission = readObjectPermission(ctx, findObjectPermission(result), data.ObjectPermission)
+
 	return nil
 }
@@ -0,0 +1,454 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &RAGIngestionResource{}
+var _ resource.ResourceWithModifyPlan = &RAGIngestionResource{}
+
+func NewRAGIngestionResource() resource.Resource {
+	return &RAGIngestionResource{}
+}
+
+type RAGIngestionResource struct {
+	client *Client
+}
+
+type RAGIngestionResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	VectorStoreID     types.String `tfsdk:"vector_store_id"`
+	CustomLLMProvider types.String `tfsdk:"custom_llm_provider"`
+	EmbeddingModel    types.String `tfsdk:"embedding_model"`
+	Documents         types.Set    `tfsdk:"documents"`
+	Manifest          types.Map    `tfsdk:"manifest"`
+	FileIDs           types.Map    `tfsdk:"file_ids"`
+}
+
+func (r *RAGIngestionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rag_ingestion"
+}
+
+func (r *RAGIngestionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Ingests local documents into a vector store through the LiteLLM RAG ingestion pipeline. Only documents whose content changed are re-ingested.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier for this ingestion.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vector_store_id": schema.StringAttribute{
+				Description: "ID of the vector store to ingest into.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_llm_provider": schema.StringAttribute{
+				Description: "Provider of the vector store. Defaults to openai.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("openai"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"embedding_model": schema.StringAttribute{
+				Description: "Model used to embed the documents, usually the model_name of a litellm_model.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"documents": schema.SetAttribute{
+				Description: "Paths of the local documents to ingest.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"manifest": schema.MapAttribute{
+				Description: "SHA-256 hash of each ingested document, keyed by path.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"file_ids": schema.MapAttribute{
+				Description: "File ID of each ingested document in the vector store, keyed by path.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *RAGIngestionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan hashes every document so that only content changes show up as
+// a diff in the manifest.
+func (r *RAGIngestionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan RAGIngestionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Documents.IsUnknown() {
+		return
+	}
+
+	var documents []string
+	resp.Diagnostics.Append(plan.Documents.ElementsAs(ctx, &documents, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	manifest, err := buildRAGManifest(documents)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("documents"), "Unable to Read Document", err.Error())
+		return
+	}
+
+	manifestValue, diags := types.MapValueFrom(ctx, types.StringType, manifest)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("manifest"), manifestValue)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state RAGIngestionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// File IDs only change when a document is re-ingested or removed.
+	if state.Manifest.Equal(manifestValue) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("file_ids"), state.FileIDs)...)
+	}
+}
+
+// resolveRAGManifest hashes the documents when they were only known at apply
+// time, leaving the manifest unknown in the plan.
+func resolveRAGManifest(ctx context.Context, data *RAGIngestionResourceModel) diag.Diagnostics {
+	if !data.Manifest.IsUnknown() {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	var documents []string
+	diags.Append(data.Documents.ElementsAs(ctx, &documents, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	manifest, err := buildRAGManifest(documents)
+	if err != nil {
+		diags.AddAttributeError(path.Root("documents"), "Unable to Read Document", err.Error())
+		return diags
+	}
+
+	manifestValue, valueDiags := types.MapValueFrom(ctx, types.StringType, manifest)
+	diags.Append(valueDiags...)
+	data.Manifest = manifestValue
+	return diags
+}
+
+func (r *RAGIngestionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RAGIngestionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resolveRAGManifest(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var manifest map[string]string
+	resp.Diagnostics.Append(data.Manifest.ElementsAs(ctx, &manifest, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(uuid.New().String())
+
+	fileIDs := map[string]string{}
+	ingested := map[string]string{}
+	for _, document := range sortedKeys(manifest) {
+		fileID, err := r.ingestDocument(ctx, &data, document)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to ingest %s: %s", document, err))
+			break
+		}
+		fileIDs[document] = fileID
+		ingested[document] = manifest[document]
+	}
+
+	// Record what was ingested so a failed create can still clean it up.
+	data.Manifest, _ = types.MapValueFrom(ctx, types.StringType, ingested)
+	data.FileIDs, _ = types.MapValueFrom(ctx, types.StringType, fileIDs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RAGIngestionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RAGIngestionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var manifest, fileIDs map[string]string
+	resp.Diagnostics.Append(data.Manifest.ElementsAs(ctx, &manifest, false)...)
+	resp.Diagnostics.Append(data.FileIDs.ElementsAs(ctx, &fileIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Drop documents whose files disappeared from the vector store so the
+	// next plan re-ingests them.
+	for document, fileID := range fileIDs {
+		endpoint := fmt.Sprintf("/v1/vector_stores/%s/files/%s", data.VectorStoreID.ValueString(), fileID)
+		err := r.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, nil)
+		if err == nil {
+			continue
+		}
+		if !IsNotFoundError(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ingested file for %s: %s", document, err))
+			return
+		}
+		delete(fileIDs, document)
+		delete(manifest, document)
+	}
+
+	data.Manifest, _ = types.MapValueFrom(ctx, types.StringType, manifest)
+	data.FileIDs, _ = types.MapValueFrom(ctx, types.StringType, fileIDs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RAGIngestionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RAGIngestionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state RAGIngestionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resolveRAGManifest(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planManifest, stateManifest, fileIDs map[string]string
+	resp.Diagnostics.Append(data.Manifest.ElementsAs(ctx, &planManifest, false)...)
+	resp.Diagnostics.Append(state.Manifest.ElementsAs(ctx, &stateManifest, false)...)
+	resp.Diagnostics.Append(state.FileIDs.ElementsAs(ctx, &fileIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if fileIDs == nil {
+		fileIDs = map[string]string{}
+	}
+
+	ingested := map[string]string{}
+	for document, hash := range stateManifest {
+		ingested[document] = hash
+	}
+
+	// Remove documents that are no longer configured.
+	for _, document := range sortedKeys(stateManifest) {
+		if _, ok := planManifest[document]; ok {
+			continue
+		}
+		if err := r.removeDocument(ctx, &data, fileIDs[document]); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove %s: %s", document, err))
+			break
+		}
+		delete(fileIDs, document)
+		delete(ingested, document)
+	}
+
+	// Re-ingest new and changed documents.
+	if !resp.Diagnostics.HasError() {
+		for _, document := range sortedKeys(planManifest) {
+			if stateManifest[document] == planManifest[document] {
+				continue
+			}
+			if oldFileID, ok := fileIDs[document]; ok {
+				if err := r.removeDocument(ctx, &data, oldFileID); err != nil {
+					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove previous version of %s: %s", document, err))
+					break
+				}
+				delete(fileIDs, document)
+				delete(ingested, document)
+			}
+
+			fileID, err := r.ingestDocument(ctx, &data, document)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to ingest %s: %s", document, err))
+				break
+			}
+			fileIDs[document] = fileID
+			ingested[document] = planManifest[document]
+		}
+	}
+
+	data.Manifest, _ = types.MapValueFrom(ctx, types.StringType, ingested)
+	data.FileIDs, _ = types.MapValueFrom(ctx, types.StringType, fileIDs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RAGIngestionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RAGIngestionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fileIDs map[string]string
+	resp.Diagnostics.Append(data.FileIDs.ElementsAs(ctx, &fileIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, document := range sortedKeys(fileIDs) {
+		if err := r.removeDocument(ctx, &data, fileIDs[document]); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove %s: %s", document, err))
+			return
+		}
+	}
+}
+
+// ingestDocument uploads one document to /v1/rag/ingest and returns the ID of
+// the file created in the vector store.
+func (r *RAGIngestionResource) ingestDocument(ctx context.Context, data *RAGIngestionResourceModel, document string) (string, error) {
+	content, err := os.ReadFile(document)
+	if err != nil {
+		return "", err
+	}
+
+	ingestOptions := map[string]interface{}{
+		"embedding": map[string]interface{}{
+			"model": data.EmbeddingModel.ValueString(),
+		},
+		"vector_store": map[string]interface{}{
+			"custom_llm_provider": data.CustomLLMProvider.ValueString(),
+			"vector_store_id":     data.VectorStoreID.ValueString(),
+		},
+	}
+	optionsJSON, err := json.Marshal(ingestOptions)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal ingest options: %w", err)
+	}
+
+	fields := map[string]string{
+		"ingest_options": string(optionsJSON),
+	}
+
+	var result map[string]interface{}
+	if err := r.client.DoMultipartRequest(ctx, "/v1/rag/ingest", fields, filepath.Base(document), content, &result); err != nil {
+		return "", err
+	}
+
+	if status, ok := result["status"].(string); ok && status == "failed" {
+		if msg, ok := result["error"].(string); ok && msg != "" {
+			return "", fmt.Errorf("ingestion failed: %s", msg)
+		}
+		return "", fmt.Errorf("ingestion failed")
+	}
+
+	fileID, _ := result["file_id"].(string)
+	if fileID == "" {
+		return "", fmt.Errorf("ingestion response did not include a file ID")
+	}
+
+	return fileID, nil
+}
+
+func (r *RAGIngestionResource) removeDocument(ctx context.Context, data *RAGIngestionResourceModel, fileID string) error {
+	if fileID == "" {
+		return nil
+	}
+
+	endpoint := fmt.Sprintf("/v1/vector_stores/%s/files/%s", data.VectorStoreID.ValueString(), fileID)
+	if err := r.client.DoRequestWithResponse(ctx, "DELETE", endpoint, nil, nil); err != nil && !IsNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
+
+// buildRAGManifest returns the SHA-256 hash of each document keyed by path.
+func buildRAGManifest(documents []string) (map[string]string, error) {
+	manifest := make(map[string]string, len(documents))
+	for _, document := range documents {
+		content, err := os.ReadFile(document)
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.Sum256(content)
+		manifest[document] = hex.EncodeToString(hash[:])
+	}
+	return manifest, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -33,6 +33,7 @@ type SearchToolResourceModel struct {
 	Timeout        types.Float64 `tfsdk:"timeout"`
 	MaxRetries     types.Int64   `tfsdk:"max_retries"`
 	SearchToolInfo types.String  `tfsdk:"search_tool_info"`
+	VerifyOnApply  types.Bool    `tfsdk:"verify_on_apply"`
 }
 
 func (r *SearchToolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -86,6 +87,10 @@ func (r *SearchToolResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Description: "Additional search tool configuration as a JSON string.",
 				Optional:    true,
 			},
+			"verify_on_apply": schema.BoolAttribute{
+				Description: "Run a test search against the provider after create and update, failing the apply if it does not succeed.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -135,6 +140,13 @@ func (r *SearchToolResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.VerifyOnApply.ValueBool() {
+		testReq := map[string]interface{}{"litellm_params": searchReq["litellm_params"]}
+		if err := r.client.VerifyConnection(ctx, "/search_tools/test_connection", testReq); err != nil {
+			resp.Diagnostics.AddError("Connection Test Failed", fmt.Sprintf("Search tool created but the connection test failed: %s", err))
+		}
+	}
 }
 
 func (r *SearchToolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -189,6 +201,13 @@ func (r *SearchToolResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.VerifyOnApply.ValueBool() {
+		testReq := map[string]interface{}{"litellm_params": searchReq["litellm_params"]}
+		if err := r.client.VerifyConnection(ctx, "/search_tools/test_connection", testReq); err != nil {
+			resp.Diagnostics.AddError("Connection Test Failed", fmt.Sprintf("Search tool updated but the connection test failed: %s", err))
+		}
+	}
 }
 
 func (r *SearchToolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
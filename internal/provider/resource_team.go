@@ -15,6 +15,8 @@ import (
 
 var _ resource.Resource = &TeamResource{}
 var _ resource.ResourceWithImportState = &TeamResource{}
+var _ resource.ResourceWithValidateConfig = &TeamResource{}
+var _ resource.ResourceWithIdentity = &TeamResource{}
 
 func NewTeamResource() resource.Resource {
 	return &TeamResource{}
@@ -25,34 +27,43 @@ type TeamResource struct {
 }
 
 type TeamResourceModel struct {
-	ID                    types.String  `tfsdk:"id"`
-	TeamAlias             types.String  `tfsdk:"team_alias"`
-	OrganizationID        types.String  `tfsdk:"organization_id"`
-	Metadata              types.Map     `tfsdk:"metadata"`
-	TPMLimit              types.Int64   `tfsdk:"tpm_limit"`
-	RPMLimit              types.Int64   `tfsdk:"rpm_limit"`
-	TPMLimitType          types.String  `tfsdk:"tpm_limit_type"`
-	RPMLimitType          types.String  `tfsdk:"rpm_limit_type"`
-	MaxBudget             types.Float64 `tfsdk:"max_budget"`
-	BudgetDuration        types.String  `tfsdk:"budget_duration"`
-	Models                types.List    `tfsdk:"models"`
-	ModelAliases          types.Map     `tfsdk:"model_aliases"`
-	ModelRPMLimit         types.Map     `tfsdk:"model_rpm_limit"`
-	ModelTPMLimit         types.Map     `tfsdk:"model_tpm_limit"`
-	Tags                  types.List    `tfsdk:"tags"`
-	Guardrails            types.List    `tfsdk:"guardrails"`
-	Prompts               types.List    `tfsdk:"prompts"`
-	Blocked               types.Bool    `tfsdk:"blocked"`
-	TeamMemberPermissions types.List    `tfsdk:"team_member_permissions"`
-	TeamMemberBudget      types.Float64 `tfsdk:"team_member_budget"`
-	TeamMemberRPMLimit    types.Int64   `tfsdk:"team_member_rpm_limit"`
-	TeamMemberTPMLimit    types.Int64   `tfsdk:"team_member_tpm_limit"`
+	ID                        types.String                `tfsdk:"id"`
+	TeamAlias                 types.String                `tfsdk:"team_alias"`
+	OrganizationID            types.String                `tfsdk:"organization_id"`
+	Metadata                  types.Map                   `tfsdk:"metadata"`
+	TPMLimit                  types.Int64                 `tfsdk:"tpm_limit"`
+	RPMLimit                  types.Int64                 `tfsdk:"rpm_limit"`
+	TPMLimitType              types.String                `tfsdk:"tpm_limit_type"`
+	RPMLimitType              types.String                `tfsdk:"rpm_limit_type"`
+	MaxBudget                 types.Float64               `tfsdk:"max_budget"`
+	BudgetDuration            types.String                `tfsdk:"budget_duration"`
+	Models                    types.List                  `tfsdk:"models"`
+	ModelAliases              types.Map                   `tfsdk:"model_aliases"`
+	ModelRPMLimit             types.Map                   `tfsdk:"model_rpm_limit"`
+	ModelTPMLimit             types.Map                   `tfsdk:"model_tpm_limit"`
+	Tags                      types.List                  `tfsdk:"tags"`
+	Guardrails                types.List                  `tfsdk:"guardrails"`
+	Prompts                   types.List                  `tfsdk:"prompts"`
+	Blocked                   types.Bool                  `tfsdk:"blocked"`
+	TeamMemberPermissions     types.List                  `tfsdk:"team_member_permissions"`
+	TeamMemberBudget          types.Float64               `tfsdk:"team_member_budget"`
+	TeamMemberRPMLimit        types.Int64                 `tfsdk:"team_member_rpm_limit"`
+	TeamMemberTPMLimit        types.Int64                 `tfsdk:"team_member_tpm_limit"`
+	TeamMemberKeyDuration     types.String                `tfsdk:"team_member_key_duration"`
+	AllowedVectorStoreIndexes types.List                  `tfsdk:"allowed_vector_store_indexes"`
+	ObjectPermission          *ObjectPermissionModel      `tfsdk:"object_permission"`
+	RouterSettings            *RouterSettingsModel        `tfsdk:"router_settings"`
+	SecretManagerSettings     *SecretManagerSettingsModel `tfsdk:"secret_manager_settings"`
 }
 
 func (r *TeamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_team"
 }
 
+func (r *TeamResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("ID of the team.")
+}
+
 func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a LiteLLM team.",
@@ -158,10 +169,33 @@ func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Description: "Default TPM limit for team members.",
 				Optional:    true,
 			},
+			"team_member_key_duration": schema.StringAttribute{
+				Description: "Default validity duration for keys created by team members (e.g. 30d).",
+				Optional:    true,
+			},
+			"allowed_vector_store_indexes": allowedVectorStoreIndexesAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"object_permission":       objectPermissionBlock(),
+			"router_settings":         routerSettingsBlock(),
+			"secret_manager_settings": secretManagerSettingsBlock(),
 		},
 	}
 }
 
+func (r *TeamResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TeamResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SecretManagerSettings != nil {
+		resp.Diagnostics.Append(validateSecretManagerSettings(data.SecretManagerSettings, path.Root("secret_manager_settings"))...)
+	}
+}
+
 func (r *TeamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -203,6 +237,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
 }
 
 func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -223,6 +258,7 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
 }
 
 func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -242,6 +278,19 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	data.ID = state.ID
 	teamReq := r.buildTeamRequest(ctx, &data, data.ID.ValueString())
 
+	if data.AllowedVectorStoreIndexes.IsNull() && !state.AllowedVectorStoreIndexes.IsNull() {
+		teamReq["allowed_vector_store_indexes"] = []map[string]interface{}{}
+	}
+	if data.ObjectPermission == nil && state.ObjectPermission != nil {
+		teamReq["object_permission"] = clearedObjectPermission()
+	}
+	if data.RouterSettings == nil && state.RouterSettings != nil {
+		teamReq["router_settings"] = map[string]interface{}{}
+	}
+	if data.SecretManagerSettings == nil && state.SecretManagerSettings != nil {
+		teamReq["secret_manager_settings"] = map[string]interface{}{}
+	}
+
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/team/update", teamReq, nil); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team: %s", err))
 		return
@@ -261,6 +310,7 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
 }
 
 func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -284,7 +334,7 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *TeamResource) buildTeamRequest(ctx context.Context, data *TeamResourceModel, teamID string) map[string]interface{} {
@@ -379,12 +429,32 @@ func (r *TeamResource) buildTeamRequest(ctx context.Context, data *TeamResourceM
 		teamReq["team_member_tpm_limit"] = data.TeamMemberTPMLimit.ValueInt64()
 	}
 
+	if !data.TeamMemberKeyDuration.IsNull() && data.TeamMemberKeyDuration.ValueString() != "" {
+		teamReq["team_member_key_duration"] = data.TeamMemberKeyDuration.ValueString()
+	}
+
 	if !data.Metadata.IsNull() {
 		var metadata map[string]string
 		data.Metadata.ElementsAs(ctx, &metadata, false)
 		teamReq["metadata"] = metadata
 	}
 
+	if !data.AllowedVectorStoreIndexes.IsNull() {
+		teamReq["allowed_vector_store_indexes"] = buildAllowedVectorStoreIndexes(ctx, data.AllowedVectorStoreIndexes)
+	}
+
+	if data.ObjectPermission != nil {
+		teamReq["object_permission"] = buildObjectPermission(ctx, data.ObjectPermission)
+	}
+
+	if data.RouterSettings != nil {
+		teamReq["router_settings"] = buildRouterSettings(ctx, data.RouterSettings)
+	}
+
+	if data.SecretManagerSettings != nil {
+		teamReq["secret_manager_settings"] = buildSecretManagerSettings(data.SecretManagerSettings)
+	}
+
 	return teamReq
 }
 
@@ -434,5 +504,10 @@ func (r *TeamResource) readTeam(ctx context.Context, data *TeamResourceModel) er
 		}
 	}
 
+	data.AllowedVectorStoreIndexes = readAllowedVectorStoreIndexes(ctx, result, data.AllowedVectorStoreIndexes, "team_info")
+	data.ObjectPermission = readObjectPermission(ctx, findObjectPermission(result, "team_info"), data.ObjectPermission)
+	data.RouterSettings = readRouterSettings(ctx, findRouterSettings(result, "team_info"), data.RouterSettings)
+	data.SecretManagerSettings = readSecretManagerSettings(findSecretManagerSettings(result), data.SecretManagerSettings)
+
 	return nil
 }
@@ -20,6 +20,7 @@ import (
 
 var _ resource.Resource = &UserResource{}
 var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithIdentity = &UserResource{}
 
 func NewUserResource() resource.Resource {
 	return &UserResource{}
@@ -30,27 +31,32 @@ type UserResource struct {
 }
 
 type UserResourceModel struct {
-	ID             types.String  `tfsdk:"id"`
-	UserID         types.String  `tfsdk:"user_id"`
-	UserAlias      types.String  `tfsdk:"user_alias"`
-	UserEmail      types.String  `tfsdk:"user_email"`
-	UserRole       types.String  `tfsdk:"user_role"`
-	Teams          types.List    `tfsdk:"teams"`
-	Models         types.List    `tfsdk:"models"`
-	MaxBudget      types.Float64 `tfsdk:"max_budget"`
-	BudgetDuration types.String  `tfsdk:"budget_duration"`
-	TPMLimit       types.Int64   `tfsdk:"tpm_limit"`
-	RPMLimit       types.Int64   `tfsdk:"rpm_limit"`
-	AutoCreateKey  types.Bool    `tfsdk:"auto_create_key"`
-	Metadata       types.Map     `tfsdk:"metadata"`
-	Spend          types.Float64 `tfsdk:"spend"`
-	Key            types.String  `tfsdk:"key"`
+	ID               types.String           `tfsdk:"id"`
+	UserID           types.String           `tfsdk:"user_id"`
+	UserAlias        types.String           `tfsdk:"user_alias"`
+	UserEmail        types.String           `tfsdk:"user_email"`
+	UserRole         types.String           `tfsdk:"user_role"`
+	Teams            types.List             `tfsdk:"teams"`
+	Models           types.List             `tfsdk:"models"`
+	MaxBudget        types.Float64          `tfsdk:"max_budget"`
+	BudgetDuration   types.String           `tfsdk:"budget_duration"`
+	TPMLimit         types.Int64            `tfsdk:"tpm_limit"`
+	RPMLimit         types.Int64            `tfsdk:"rpm_limit"`
+	AutoCreateKey    types.Bool             `tfsdk:"auto_create_key"`
+	Metadata         types.Map              `tfsdk:"metadata"`
+	Spend            types.Float64          `tfsdk:"spend"`
+	Key              types.String           `tfsdk:"key"`
+	ObjectPermission *ObjectPermissionModel `tfsdk:"object_permission"`
 }
 
 func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_user"
 }
 
+func (r *UserResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("ID of the user.")
+}
+
 func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a LiteLLM internal user. Internal users can access the LiteLLM Admin UI to manage keys and request access to models.",
@@ -152,6 +158,9 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Sensitive:   true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"object_permission": objectPermissionBlock(),
+		},
 	}
 }
 
@@ -205,6 +214,7 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
 }
 
 func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -225,6 +235,7 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
 }
 
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -249,6 +260,10 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	userReq := r.buildUserRequest(ctx, &data)
 	userReq["user_id"] = data.UserID.ValueString()
 
+	if data.ObjectPermission == nil && state.ObjectPermission != nil {
+		userReq["object_permission"] = clearedObjectPermission()
+	}
+
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/user/update", userReq, nil); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user: %s", err))
 		return
@@ -260,6 +275,7 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.ID.ValueString())...)
 }
 
 func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -283,8 +299,14 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), req.ID)...)
+	id, diags := importID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), id)...)
 }
 
 func (r *UserResource) buildUserRequest(ctx context.Context, data *UserResourceModel) map[string]interface{} {
@@ -336,6 +358,10 @@ func (r *UserResource) buildUserRequest(ctx context.Context, data *UserResourceM
 		userReq["metadata"] = metadata
 	}
 
+	if data.ObjectPermission != nil {
+		userReq["object_permission"] = buildObjectPermission(ctx, data.ObjectPermission)
+	}
+
 	return userReq
 }
 
@@ -423,5 +449,7 @@ func (r *UserResource) readUser(ctx context.Context, data *UserResourceModel) er
 		data.Metadata, _ = types.MapValue(types.StringType, metaMap)
 	}
 
+	data.ObjectPermission = readObjectPermission(ctx, findObjectPermission(result, "user_info"), data.ObjectPermission)
+
 	return nil
 }
@@ -0,0 +1,552 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &VectorStoreFileResource{}
+var _ resource.ResourceWithImportState = &VectorStoreFileResource{}
+var _ resource.ResourceWithModifyPlan = &VectorStoreFileResource{}
+
+func NewVectorStoreFileResource() resource.Resource {
+	return &VectorStoreFileResource{}
+}
+
+type VectorStoreFileResource struct {
+	client *Client
+}
+
+type ChunkingStrategyModel struct {
+	Type               types.String `tfsdk:"type"`
+	MaxChunkSizeTokens types.Int64  `tfsdk:"max_chunk_size_tokens"`
+	ChunkOverlapTokens types.Int64  `tfsdk:"chunk_overlap_tokens"`
+}
+
+type VectorStoreFileResourceModel struct {
+	ID                types.String           `tfsdk:"id"`
+	VectorStoreID     types.String           `tfsdk:"vector_store_id"`
+	FileID            types.String           `tfsdk:"file_id"`
+	Source            types.String           `tfsdk:"source"`
+	Content           types.String           `tfsdk:"content"`
+	Filename          types.String           `tfsdk:"filename"`
+	CustomLLMProvider types.String           `tfsdk:"custom_llm_provider"`
+	Attributes        types.Map              `tfsdk:"attributes"`
+	IngestionTimeout  types.Int64            `tfsdk:"ingestion_timeout"`
+	ChunkingStrategy  *ChunkingStrategyModel `tfsdk:"chunking_strategy"`
+	ContentHash       types.String           `tfsdk:"content_hash"`
+	Status            types.String           `tfsdk:"status"`
+	UsageBytes        types.Int64            `tfsdk:"usage_bytes"`
+	LastError         types.String           `tfsdk:"last_error"`
+}
+
+func (r *VectorStoreFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vector_store_file"
+}
+
+func (r *VectorStoreFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Uploads a document through the LiteLLM files API and attaches it to a vector store.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier for this attachment (vector_store_id:file_id).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vector_store_id": schema.StringAttribute{
+				Description: "ID of the vector store to attach the file to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_id": schema.StringAttribute{
+				Description: "ID of the uploaded file.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "Path to a local file to upload. Conflicts with content.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content")),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "Inline content to upload. Conflicts with source.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"filename": schema.StringAttribute{
+				Description: "Filename to upload the document as. Defaults to the base name of source, or content.txt for inline content.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"custom_llm_provider": schema.StringAttribute{
+				Description: "Provider the file is uploaded to. Defaults to openai.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("openai"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"attributes": schema.MapAttribute{
+				Description: "Attributes to attach to the file, used for filtering searches.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"ingestion_timeout": schema.Int64Attribute{
+				Description: "Seconds to wait for the upload and for ingestion to complete. Defaults to 600.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(600),
+			},
+			"content_hash": schema.StringAttribute{
+				Description: "SHA-256 hash of the uploaded content. A change re-uploads the file.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Ingestion status of the file (in_progress, completed, failed, cancelled).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"usage_bytes": schema.Int64Attribute{
+				Description: "Storage used by the file in the vector store, in bytes.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_error": schema.StringAttribute{
+				Description: "Error reported by the last ingestion attempt, if any.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"chunking_strategy": schema.SingleNestedBlock{
+				Description: "Chunking strategy used when ingesting the file.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Chunking strategy type (auto, static).",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("auto", "static"),
+						},
+					},
+					"max_chunk_size_tokens": schema.Int64Attribute{
+						Description: "Maximum number of tokens in each chunk (static only).",
+						Optional:    true,
+					},
+					"chunk_overlap_tokens": schema.Int64Attribute{
+						Description: "Number of tokens that overlap between chunks (static only).",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *VectorStoreFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan hashes the document so that a change to the local file or inline
+// content plans a re-upload.
+func (r *VectorStoreFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan VectorStoreFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A document that is only known at apply time may differ from the uploaded
+	// one, and only Create uploads, so it is replaced.
+	if plan.Source.IsUnknown() || plan.Content.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), types.StringUnknown())...)
+		if !req.State.Raw.IsNull() {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_hash"))
+		}
+		return
+	}
+
+	content, fileName, err := loadVectorStoreFileContent(&plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Unable to Read File", err.Error())
+		return
+	}
+
+	hash := sha256.Sum256(content)
+	contentHash := hex.EncodeToString(hash[:])
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), contentHash)...)
+
+	if plan.Filename.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("filename"), fileName)...)
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state VectorStoreFileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.ContentHash.IsNull() && state.ContentHash.ValueString() != contentHash {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_hash"))
+	}
+	if !state.Filename.IsNull() && state.Filename.ValueString() != fileName {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("filename"))
+	}
+}
+
+func (r *VectorStoreFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VectorStoreFileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, fileName, err := loadVectorStoreFileContent(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("File Error", fmt.Sprintf("Unable to read file: %s", err))
+		return
+	}
+
+	hash := sha256.Sum256(content)
+	data.ContentHash = types.StringValue(hex.EncodeToString(hash[:]))
+	data.Filename = types.StringValue(fileName)
+
+	fields := map[string]string{
+		"purpose":             "assistants",
+		"custom_llm_provider": data.CustomLLMProvider.ValueString(),
+	}
+
+	// The upload shares ingestion_timeout, so large files are not cut off at
+	// the provider's request timeout.
+	uploadCtx, cancel := context.WithTimeout(ctx, time.Duration(data.IngestionTimeout.ValueInt64())*time.Second)
+	defer cancel()
+
+	var uploadResult map[string]interface{}
+	if err := r.client.DoMultipartRequest(uploadCtx, "/v1/files", fields, fileName, content, &uploadResult); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload file: %s", err))
+		return
+	}
+
+	fileID, ok := uploadResult["id"].(string)
+	if !ok || fileID == "" {
+		resp.Diagnostics.AddError("Client Error", "File upload response did not include a file ID")
+		return
+	}
+
+	vectorStoreID := data.VectorStoreID.ValueString()
+	attachReq := r.buildAttachRequest(ctx, &data, fileID)
+
+	endpoint := fmt.Sprintf("/v1/vector_stores/%s/files", vectorStoreID)
+	if err := r.client.DoRequestWithResponse(ctx, "POST", endpoint, attachReq, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to attach file %s to vector store: %s", fileID, err))
+		return
+	}
+
+	data.FileID = types.StringValue(fileID)
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", vectorStoreID, fileID))
+
+	waitErr := r.waitForIngestion(ctx, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if waitErr != nil {
+		resp.Diagnostics.AddError("Ingestion Error", fmt.Sprintf("File %s was attached but ingestion did not complete: %s", fileID, waitErr))
+	}
+}
+
+func (r *VectorStoreFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VectorStoreFileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.readVectorStoreFile(ctx, &data); err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read vector store file: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VectorStoreFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VectorStoreFileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state VectorStoreFileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only attributes can change in place; everything else forces a re-upload.
+	data.ID = state.ID
+	data.FileID = state.FileID
+	data.ContentHash = state.ContentHash
+	data.Filename = state.Filename
+
+	updateReq := map[string]interface{}{
+		"attributes": map[string]string{},
+	}
+	if !data.Attributes.IsNull() {
+		var attributes map[string]string
+		data.Attributes.ElementsAs(ctx, &attributes, false)
+		updateReq["attributes"] = attributes
+	}
+
+	endpoint := fmt.Sprintf("/v1/vector_stores/%s/files/%s", data.VectorStoreID.ValueString(), data.FileID.ValueString())
+	if err := r.client.DoRequestWithResponse(ctx, "POST", endpoint, updateReq, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update vector store file: %s", err))
+		return
+	}
+
+	// Ingestion is not rerun by an update, so the planned ingestion fields are
+	// kept; a refresh picks up any later change.
+	status, usageBytes, lastError := data.Status, data.UsageBytes, data.LastError
+	if err := r.readVectorStoreFile(ctx, &data); err != nil {
+		resp.Diagnostics.AddWarning("Read Error", fmt.Sprintf("Vector store file updated but failed to read back: %s", err))
+	}
+	data.Status, data.UsageBytes, data.LastError = status, usageBytes, lastError
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VectorStoreFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VectorStoreFileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fileID := data.FileID.ValueString()
+
+	endpoint := fmt.Sprintf("/v1/vector_stores/%s/files/%s", data.VectorStoreID.ValueString(), fileID)
+	if err := r.client.DoRequestWithResponse(ctx, "DELETE", endpoint, nil, nil); err != nil {
+		if !IsNotFoundError(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to detach vector store file: %s", err))
+			return
+		}
+	}
+
+	if err := r.client.DoRequestWithResponse(ctx, "DELETE", fmt.Sprintf("/v1/files/%s", fileID), nil, nil); err != nil {
+		if !IsNotFoundError(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete file: %s", err))
+			return
+		}
+	}
+}
+
+func (r *VectorStoreFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID format: vector_store_id:file_id
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("Invalid Import ID", "Import ID must be in format vector_store_id:file_id")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vector_store_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("file_id"), parts[1])...)
+}
+
+func (r *VectorStoreFileResource) buildAttachRequest(ctx context.Context, data *VectorStoreFileResourceModel, fileID string) map[string]interface{} {
+	attachReq := map[string]interface{}{
+		"file_id": fileID,
+	}
+
+	if !data.Attributes.IsNull() {
+		var attributes map[string]string
+		data.Attributes.ElementsAs(ctx, &attributes, false)
+		attachReq["attributes"] = attributes
+	}
+
+	if data.ChunkingStrategy != nil && !data.ChunkingStrategy.Type.IsNull() {
+		strategy := map[string]interface{}{
+			"type": data.ChunkingStrategy.Type.ValueString(),
+		}
+		if data.ChunkingStrategy.Type.ValueString() == "static" {
+			static := map[string]interface{}{}
+			if !data.ChunkingStrategy.MaxChunkSizeTokens.IsNull() {
+				static["max_chunk_size_tokens"] = data.ChunkingStrategy.MaxChunkSizeTokens.ValueInt64()
+			}
+			if !data.ChunkingStrategy.ChunkOverlapTokens.IsNull() {
+				static["chunk_overlap_tokens"] = data.ChunkingStrategy.ChunkOverlapTokens.ValueInt64()
+			}
+			strategy["static"] = static
+		}
+		attachReq["chunking_strategy"] = strategy
+	}
+
+	return attachReq
+}
+
+// waitForIngestion polls the attachment until ingestion completes, fails or the
+// configured timeout is reached.
+func (r *VectorStoreFileResource) waitForIngestion(ctx context.Context, data *VectorStoreFileResourceModel) error {
+	deadline := time.Now().Add(time.Duration(data.IngestionTimeout.ValueInt64()) * time.Second)
+	delay := 1 * time.Second
+	maxDelay := 10 * time.Second
+
+	for {
+		if err := r.readVectorStoreFile(ctx, data); err != nil && !IsNotFoundError(err) {
+			return err
+		}
+
+		switch data.Status.ValueString() {
+		case "completed":
+			return nil
+		case "failed", "cancelled":
+			if data.LastError.ValueString() != "" {
+				return fmt.Errorf("ingestion %s: %s", data.Status.ValueString(), data.LastError.ValueString())
+			}
+			return fmt.Errorf("ingestion %s", data.Status.ValueString())
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for ingestion, last status %q", data.Status.ValueString())
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+}
+
+func (r *VectorStoreFileResource) readVectorStoreFile(ctx context.Context, data *VectorStoreFileResourceModel) error {
+	endpoint := fmt.Sprintf("/v1/vector_stores/%s/files/%s", data.VectorStoreID.ValueString(), data.FileID.ValueString())
+
+	var result map[string]interface{}
+	if err := r.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
+		return err
+	}
+
+	if status, ok := result["status"].(string); ok {
+		data.Status = types.StringValue(status)
+	} else {
+		data.Status = types.StringNull()
+	}
+
+	if usageBytes, ok := result["usage_bytes"].(float64); ok {
+		data.UsageBytes = types.Int64Value(int64(usageBytes))
+	} else {
+		data.UsageBytes = types.Int64Null()
+	}
+
+	data.LastError = types.StringNull()
+	if lastError, ok := result["last_error"].(map[string]interface{}); ok {
+		if message, ok := lastError["message"].(string); ok {
+			data.LastError = types.StringValue(message)
+		}
+	}
+
+	if attributes, ok := result["attributes"].(map[string]interface{}); ok && (len(attributes) > 0 || !data.Attributes.IsNull()) {
+		attrMap := make(map[string]attr.Value)
+		for k, v := range attributes {
+			attrMap[k] = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		data.Attributes, _ = types.MapValue(types.StringType, attrMap)
+	}
+
+	return nil
+}
+
+// loadVectorStoreFileContent returns the document bytes and upload filename
+// from either the source path or the inline content.
+func loadVectorStoreFileContent(data *VectorStoreFileResourceModel) ([]byte, string, error) {
+	var content []byte
+	fileName := "content.txt"
+
+	if !data.Source.IsNull() && data.Source.ValueString() != "" {
+		source := data.Source.ValueString()
+		fileBytes, err := os.ReadFile(source)
+		if err != nil {
+			return nil, "", err
+		}
+		content = fileBytes
+		fileName = filepath.Base(source)
+	} else {
+		content = []byte(data.Content.ValueString())
+	}
+
+	if !data.Filename.IsNull() && !data.Filename.IsUnknown() && data.Filename.ValueString() != "" {
+		fileName = data.Filename.ValueString()
+	}
+
+	return content, fileName, nil
+}
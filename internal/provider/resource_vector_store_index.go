@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &VectorStoreIndexResource{}
+
+func NewVectorStoreIndexResource() resource.Resource {
+	return &VectorStoreIndexResource{}
+}
+
+// VectorStoreIndexResource manages a named index on a vector store. The proxy
+// only exposes a create endpoint for indexes, so every change forces a new
+// index and Read and Delete only act on Terraform state.
+type VectorStoreIndexResource struct {
+	client *Client
+}
+
+type VectorStoreIndexResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	IndexName        types.String `tfsdk:"index_name"`
+	VectorStoreName  types.String `tfsdk:"vector_store_name"`
+	VectorStoreIndex types.String `tfsdk:"vector_store_index"`
+	IndexInfo        types.Map    `tfsdk:"index_info"`
+}
+
+func (r *VectorStoreIndexResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vector_store_index"
+}
+
+func (r *VectorStoreIndexResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a LiteLLM vector store index. Keys and teams are granted access to indexes through allowed_vector_store_indexes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The index name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"index_name": schema.StringAttribute{
+				Description: "Name of the index, used in allowed_vector_store_indexes.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vector_store_name": schema.StringAttribute{
+				Description: "Name of the vector store the index belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vector_store_index": schema.StringAttribute{
+				Description: "Name of the index in the underlying vector store provider.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"index_info": schema.MapAttribute{
+				Description: "Additional information stored with the index.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VectorStoreIndexResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VectorStoreIndexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VectorStoreIndexResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	indexReq := map[string]interface{}{
+		"index_name": data.IndexName.ValueString(),
+		"litellm_params": map[string]interface{}{
+			"vector_store_name":  data.VectorStoreName.ValueString(),
+			"vector_store_index": data.VectorStoreIndex.ValueString(),
+		},
+	}
+
+	if !data.IndexInfo.IsNull() {
+		var indexInfo map[string]string
+		data.IndexInfo.ElementsAs(ctx, &indexInfo, false)
+		indexReq["index_info"] = indexInfo
+	}
+
+	if err := r.client.DoRequestWithResponse(ctx, "POST", "/v1/indexes", indexReq, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create vector store index: %s", err))
+		return
+	}
+
+	data.ID = data.IndexName
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VectorStoreIndexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VectorStoreIndexResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// There is no endpoint to read an index back, so the state is kept as is.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VectorStoreIndexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute forces replacement, so Update is never called with changes.
+	var data VectorStoreIndexResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VectorStoreIndexResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VectorStoreIndexResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Index Not Deleted",
+		fmt.Sprintf("The proxy has no endpoint to delete vector store indexes, so index %q was only removed from Terraform state.", data.IndexName.ValueString()),
+	)
+}
+
+// AllowedVectorStoreIndexModel grants a key or team access to an index.
+type AllowedVectorStoreIndexModel struct {
+	IndexName        types.String `tfsdk:"index_name"`
+	IndexPermissions types.List   `tfsdk:"index_permissions"`
+}
+
+var allowedVectorStoreIndexType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"index_name":        types.StringType,
+		"index_permissions": types.ListType{ElemType: types.StringType},
+	},
+}
+
+func allowedVectorStoreIndexesAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "Vector store indexes that may be used, with the permissions granted on each.",
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"index_name": schema.StringAttribute{
+					Description: "Name of the index.",
+					Required:    true,
+				},
+				"index_permissions": schema.ListAttribute{
+					Description: "Permissions on the index. Valid values: read, write.",
+					Required:    true,
+					ElementType: types.StringType,
+					Validators: []validator.List{
+						listvalidator.ValueStringsAre(stringvalidator.OneOf("read", "write")),
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildAllowedVectorStoreIndexes(ctx context.Context, list types.List) []map[string]interface{} {
+	var items []AllowedVectorStoreIndexModel
+	list.ElementsAs(ctx, &items, false)
+
+	indexes := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		permissions := []string{}
+		item.IndexPermissions.ElementsAs(ctx, &permissions, false)
+		indexes = append(indexes, map[string]interface{}{
+			"index_name":        item.IndexName.ValueString(),
+			"index_permissions": permissions,
+		})
+	}
+	return indexes
+}
+
+// readAllowedVectorStoreIndexes looks for allowed_vector_store_indexes at the
+// top level of an info response and then inside the given nested objects. An
+// empty result leaves an unset attribute null.
+func readAllowedVectorStoreIndexes(ctx context.Context, result map[string]interface{}, current types.List, nestedKeys ...string) types.List {
+	raw, ok := result["allowed_vector_store_indexes"].([]interface{})
+	for _, key := range nestedKeys {
+		if ok {
+			break
+		}
+		if nested, isMap := result[key].(map[string]interface{}); isMap {
+			raw, ok = nested["allowed_vector_store_indexes"].([]interface{})
+		}
+	}
+	if !ok || (len(raw) == 0 && current.IsNull()) {
+		return current
+	}
+
+	items := make([]AllowedVectorStoreIndexModel, 0, len(raw))
+	for _, r := range raw {
+		entry, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		item := AllowedVectorStoreIndexModel{
+			IndexName: types.StringNull(),
+		}
+		if name, ok := entry["index_name"].(string); ok {
+			item.IndexName = types.StringValue(name)
+		}
+		permissions, _ := entry["index_permissions"].([]interface{})
+		item.IndexPermissions, _ = types.ListValueFrom(ctx, types.StringType, interfaceSliceToStrings(permissions))
+		items = append(items, item)
+	}
+
+	list, diags := types.ListValueFrom(ctx, allowedVectorStoreIndexType, items)
+	if diags.HasError() {
+		return current
+	}
+	return list
+}
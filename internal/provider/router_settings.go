@@ -0,0 +1,337 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// routingStrategies are the strategies accepted by the LiteLLM router.
+var routingStrategies = []string{
+	"simple-shuffle",
+	"least-busy",
+	"usage-based-routing",
+	"usage-based-routing-v2",
+	"latency-based-routing",
+	"cost-based-routing",
+}
+
+// RouterSettingsModel overrides the proxy's router settings for requests made
+// with a key or by a team. It is shared by the key and team resources.
+type RouterSettingsModel struct {
+	RoutingStrategy        types.String  `tfsdk:"routing_strategy"`
+	RoutingStrategyArgs    types.String  `tfsdk:"routing_strategy_args"`
+	NumRetries             types.Int64   `tfsdk:"num_retries"`
+	MaxRetries             types.Int64   `tfsdk:"max_retries"`
+	Timeout                types.Float64 `tfsdk:"timeout"`
+	CooldownTime           types.Float64 `tfsdk:"cooldown_time"`
+	AllowedFails           types.Int64   `tfsdk:"allowed_fails"`
+	RetryAfter             types.Float64 `tfsdk:"retry_after"`
+	Fallbacks              types.Map     `tfsdk:"fallbacks"`
+	ContextWindowFallbacks types.Map     `tfsdk:"context_window_fallbacks"`
+	ModelGroupAlias        types.Map     `tfsdk:"model_group_alias"`
+	ModelGroupRetryPolicy  types.String  `tfsdk:"model_group_retry_policy"`
+}
+
+func routerSettingsBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "Router settings that override the proxy defaults for these requests.",
+		Attributes: map[string]schema.Attribute{
+			"routing_strategy": schema.StringAttribute{
+				Description: "Routing strategy. Valid values: simple-shuffle, least-busy, usage-based-routing, usage-based-routing-v2, latency-based-routing, cost-based-routing.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(routingStrategies...),
+				},
+			},
+			"routing_strategy_args": schema.StringAttribute{
+				Description: "JSON string of arguments for the routing strategy (e.g. ttl for latency-based-routing).",
+				Optional:    true,
+				Validators: []validator.String{
+					jsonObjectValidator{},
+				},
+			},
+			"num_retries": schema.Int64Attribute{
+				Description: "Number of times to retry a failed request.",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum retries passed to the underlying provider client.",
+				Optional:    true,
+			},
+			"timeout": schema.Float64Attribute{
+				Description: "Request timeout in seconds.",
+				Optional:    true,
+			},
+			"cooldown_time": schema.Float64Attribute{
+				Description: "Seconds a deployment is cooled down after exceeding allowed_fails.",
+				Optional:    true,
+			},
+			"allowed_fails": schema.Int64Attribute{
+				Description: "Number of failures allowed per minute before a deployment is cooled down.",
+				Optional:    true,
+			},
+			"retry_after": schema.Float64Attribute{
+				Description: "Minimum seconds to wait before retrying a request.",
+				Optional:    true,
+			},
+			"fallbacks": schema.MapAttribute{
+				Description: "Map of model group to the model groups to fall back to, in order.",
+				Optional:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
+			"context_window_fallbacks": schema.MapAttribute{
+				Description: "Map of model group to the model groups to fall back to on context window errors.",
+				Optional:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
+			"model_group_alias": schema.MapAttribute{
+				Description: "Map of alias to model group name.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"model_group_retry_policy": schema.StringAttribute{
+				Description: "JSON string of per model group retry policies, keyed by model group.",
+				Optional:    true,
+				Validators: []validator.String{
+					jsonObjectValidator{},
+				},
+			},
+		},
+	}
+}
+
+func buildRouterSettings(ctx context.Context, rs *RouterSettingsModel) map[string]interface{} {
+	settings := map[string]interface{}{}
+
+	if !rs.RoutingStrategy.IsNull() && rs.RoutingStrategy.ValueString() != "" {
+		settings["routing_strategy"] = rs.RoutingStrategy.ValueString()
+	}
+	if !rs.NumRetries.IsNull() {
+		settings["num_retries"] = rs.NumRetries.ValueInt64()
+	}
+	if !rs.MaxRetries.IsNull() {
+		settings["max_retries"] = rs.MaxRetries.ValueInt64()
+	}
+	if !rs.Timeout.IsNull() {
+		settings["timeout"] = rs.Timeout.ValueFloat64()
+	}
+	if !rs.CooldownTime.IsNull() {
+		settings["cooldown_time"] = rs.CooldownTime.ValueFloat64()
+	}
+	if !rs.AllowedFails.IsNull() {
+		settings["allowed_fails"] = rs.AllowedFails.ValueInt64()
+	}
+	if !rs.RetryAfter.IsNull() {
+		settings["retry_after"] = rs.RetryAfter.ValueFloat64()
+	}
+
+	if !rs.Fallbacks.IsNull() {
+		settings["fallbacks"] = buildRouterFallbacks(ctx, rs.Fallbacks)
+	}
+	if !rs.ContextWindowFallbacks.IsNull() {
+		settings["context_window_fallbacks"] = buildRouterFallbacks(ctx, rs.ContextWindowFallbacks)
+	}
+
+	if !rs.ModelGroupAlias.IsNull() {
+		var aliases map[string]string
+		rs.ModelGroupAlias.ElementsAs(ctx, &aliases, false)
+		settings["model_group_alias"] = aliases
+	}
+
+	if !rs.RoutingStrategyArgs.IsNull() && rs.RoutingStrategyArgs.ValueString() != "" {
+		var args map[string]interface{}
+		if err := json.Unmarshal([]byte(rs.RoutingStrategyArgs.ValueString()), &args); err == nil {
+			settings["routing_strategy_args"] = args
+		}
+	}
+	if !rs.ModelGroupRetryPolicy.IsNull() && rs.ModelGroupRetryPolicy.ValueString() != "" {
+		var policy map[string]interface{}
+		if err := json.Unmarshal([]byte(rs.ModelGroupRetryPolicy.ValueString()), &policy); err == nil {
+			settings["model_group_retry_policy"] = policy
+		}
+	}
+
+	return settings
+}
+
+var _ validator.String = jsonObjectValidator{}
+
+// jsonObjectValidator checks that a string holds a JSON object, so a typo is
+// reported instead of the value being left out of the request.
+type jsonObjectValidator struct{}
+
+func (v jsonObjectValidator) Description(ctx context.Context) string {
+	return "value must be a JSON object"
+}
+
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &object); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Object",
+			fmt.Sprintf("The value must be a JSON object: %s", err),
+		)
+	}
+}
+
+// buildRouterFallbacks converts a model group => fallbacks map into the list of
+// single-entry objects the router expects, e.g. [{"gpt-4o": ["gpt-4o-mini"]}].
+func buildRouterFallbacks(ctx context.Context, m types.Map) []map[string][]string {
+	var fallbacks map[string][]string
+	m.ElementsAs(ctx, &fallbacks, false)
+
+	groups := make([]string, 0, len(fallbacks))
+	for group := range fallbacks {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	result := make([]map[string][]string, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, map[string][]string{group: fallbacks[group]})
+	}
+	return result
+}
+
+// findRouterSettings returns the router_settings from an info response, looking
+// at the top level first and then inside the given nested objects.
+func findRouterSettings(result map[string]interface{}, nestedKeys ...string) map[string]interface{} {
+	if rs, ok := result["router_settings"].(map[string]interface{}); ok {
+		return rs
+	}
+	for _, key := range nestedKeys {
+		if nested, ok := result[key].(map[string]interface{}); ok {
+			if rs, ok := nested["router_settings"].(map[string]interface{}); ok {
+				return rs
+			}
+		}
+	}
+	return nil
+}
+
+// readRouterSettings maps the API response onto the block. The proxy stores
+// router_settings exactly as sent, so absent fields are read back as null.
+func readRouterSettings(ctx context.Context, raw map[string]interface{}, current *RouterSettingsModel) *RouterSettingsModel {
+	if raw == nil {
+		return current
+	}
+	if current == nil && len(raw) == 0 {
+		return nil
+	}
+
+	rs := &RouterSettingsModel{
+		RoutingStrategy:        types.StringNull(),
+		RoutingStrategyArgs:    types.StringNull(),
+		NumRetries:             types.Int64Null(),
+		MaxRetries:             types.Int64Null(),
+		Timeout:                types.Float64Null(),
+		CooldownTime:           types.Float64Null(),
+		AllowedFails:           types.Int64Null(),
+		RetryAfter:             types.Float64Null(),
+		Fallbacks:              types.MapNull(types.ListType{ElemType: types.StringType}),
+		ContextWindowFallbacks: types.MapNull(types.ListType{ElemType: types.StringType}),
+		ModelGroupAlias:        types.MapNull(types.StringType),
+		ModelGroupRetryPolicy:  types.StringNull(),
+	}
+
+	if strategy, ok := raw["routing_strategy"].(string); ok {
+		rs.RoutingStrategy = types.StringValue(strategy)
+	}
+	if numRetries, ok := raw["num_retries"].(float64); ok {
+		rs.NumRetries = types.Int64Value(int64(numRetries))
+	}
+	if maxRetries, ok := raw["max_retries"].(float64); ok {
+		rs.MaxRetries = types.Int64Value(int64(maxRetries))
+	}
+	if timeout, ok := raw["timeout"].(float64); ok {
+		rs.Timeout = types.Float64Value(timeout)
+	}
+	if cooldown, ok := raw["cooldown_time"].(float64); ok {
+		rs.CooldownTime = types.Float64Value(cooldown)
+	}
+	if allowedFails, ok := raw["allowed_fails"].(float64); ok {
+		rs.AllowedFails = types.Int64Value(int64(allowedFails))
+	}
+	if retryAfter, ok := raw["retry_after"].(float64); ok {
+		rs.RetryAfter = types.Float64Value(retryAfter)
+	}
+
+	if fallbacks, ok := raw["fallbacks"].([]interface{}); ok {
+		rs.Fallbacks = readRouterFallbacks(ctx, fallbacks)
+	}
+	if fallbacks, ok := raw["context_window_fallbacks"].([]interface{}); ok {
+		rs.ContextWindowFallbacks = readRouterFallbacks(ctx, fallbacks)
+	}
+
+	if aliases, ok := raw["model_group_alias"].(map[string]interface{}); ok && len(aliases) > 0 {
+		aliasMap := make(map[string]string)
+		for k, v := range aliases {
+			if str, ok := v.(string); ok {
+				aliasMap[k] = str
+			}
+		}
+		rs.ModelGroupAlias, _ = types.MapValueFrom(ctx, types.StringType, aliasMap)
+	}
+
+	var currentArgs, currentPolicy types.String
+	if current != nil {
+		currentArgs = current.RoutingStrategyArgs
+		currentPolicy = current.ModelGroupRetryPolicy
+	}
+	if args, ok := raw["routing_strategy_args"].(map[string]interface{}); ok {
+		rs.RoutingStrategyArgs = jsonStringPreservingState(args, currentArgs)
+	}
+	if policy, ok := raw["model_group_retry_policy"].(map[string]interface{}); ok {
+		rs.ModelGroupRetryPolicy = jsonStringPreservingState(policy, currentPolicy)
+	}
+
+	return rs
+}
+
+func readRouterFallbacks(ctx context.Context, fallbacks []interface{}) types.Map {
+	fallbackMap := make(map[string][]string)
+	for _, f := range fallbacks {
+		if entry, ok := f.(map[string]interface{}); ok {
+			for group, targets := range entry {
+				if list, ok := targets.([]interface{}); ok {
+					fallbackMap[group] = interfaceSliceToStrings(list)
+				}
+			}
+		}
+	}
+	result, _ := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, fallbackMap)
+	return result
+}
+
+// jsonStringPreservingState encodes value as JSON, keeping the configured string
+// when it decodes to the same value so formatting differences don't cause a diff.
+func jsonStringPreservingState(value map[string]interface{}, current types.String) types.String {
+	if !current.IsNull() && !current.IsUnknown() {
+		var configured map[string]interface{}
+		if err := json.Unmarshal([]byte(current.ValueString()), &configured); err == nil && reflect.DeepEqual(configured, value) {
+			return current
+		}
+	}
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return current
+	}
+	return types.StringValue(string(jsonBytes))
+}
@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// secretManagerTypes are the secret backends a team can store its keys in.
+var secretManagerTypes = []string{
+	"aws_secret_manager",
+	"hashicorp_vault",
+	"google_secret_manager",
+	"azure_key_vault",
+}
+
+// SecretManagerSettingsModel configures the secret backend a team's virtual
+// keys are stored in.
+type SecretManagerSettingsModel struct {
+	Type                       types.String `tfsdk:"type"`
+	StoreVirtualKeys           types.Bool   `tfsdk:"store_virtual_keys"`
+	PrefixForStoredVirtualKeys types.String `tfsdk:"prefix_for_stored_virtual_keys"`
+	Description                types.String `tfsdk:"description"`
+
+	AWSRegionName      types.String `tfsdk:"aws_region_name"`
+	AWSRoleName        types.String `tfsdk:"aws_role_name"`
+	AWSSessionName     types.String `tfsdk:"aws_session_name"`
+	AWSAccessKeyID     types.String `tfsdk:"aws_access_key_id"`
+	AWSSecretAccessKey types.String `tfsdk:"aws_secret_access_key"`
+
+	VaultAddr            types.String `tfsdk:"vault_addr"`
+	VaultNamespace       types.String `tfsdk:"vault_namespace"`
+	VaultMountName       types.String `tfsdk:"vault_mount_name"`
+	VaultPathPrefix      types.String `tfsdk:"vault_path_prefix"`
+	VaultToken           types.String `tfsdk:"vault_token"`
+	VaultApproleRoleID   types.String `tfsdk:"vault_approle_role_id"`
+	VaultApproleSecretID types.String `tfsdk:"vault_approle_secret_id"`
+
+	GoogleProjectID       types.String `tfsdk:"google_project_id"`
+	GoogleCredentialsJSON types.String `tfsdk:"google_credentials_json"`
+
+	AzureKeyVaultURI  types.String `tfsdk:"azure_key_vault_uri"`
+	AzureTenantID     types.String `tfsdk:"azure_tenant_id"`
+	AzureClientID     types.String `tfsdk:"azure_client_id"`
+	AzureClientSecret types.String `tfsdk:"azure_client_secret"`
+}
+
+// secretManagerField describes one backend-specific string attribute.
+type secretManagerField struct {
+	name        string
+	backend     string
+	sensitive   bool
+	description string
+	value       *types.String
+}
+
+// backendFields returns the backend-specific attributes of the block. The
+// attribute name doubles as the key sent to the proxy.
+func (m *SecretManagerSettingsModel) backendFields() []secretManagerField {
+	return []secretManagerField{
+		{"aws_region_name", "aws_secret_manager", false, "AWS region of the secrets manager.", &m.AWSRegionName},
+		{"aws_role_name", "aws_secret_manager", false, "IAM role to assume when accessing the secrets manager.", &m.AWSRoleName},
+		{"aws_session_name", "aws_secret_manager", false, "Session name used when assuming aws_role_name.", &m.AWSSessionName},
+		{"aws_access_key_id", "aws_secret_manager", false, "AWS access key ID.", &m.AWSAccessKeyID},
+		{"aws_secret_access_key", "aws_secret_manager", true, "AWS secret access key.", &m.AWSSecretAccessKey},
+		{"vault_addr", "hashicorp_vault", false, "Address of the Vault server.", &m.VaultAddr},
+		{"vault_namespace", "hashicorp_vault", false, "Vault Enterprise namespace.", &m.VaultNamespace},
+		{"vault_mount_name", "hashicorp_vault", false, "Name of the KV secrets engine mount.", &m.VaultMountName},
+		{"vault_path_prefix", "hashicorp_vault", false, "Path prefix for secrets within the mount.", &m.VaultPathPrefix},
+		{"vault_token", "hashicorp_vault", true, "Vault token. Conflicts with the AppRole credentials.", &m.VaultToken},
+		{"vault_approle_role_id", "hashicorp_vault", false, "AppRole role ID.", &m.VaultApproleRoleID},
+		{"vault_approle_secret_id", "hashicorp_vault", true, "AppRole secret ID.", &m.VaultApproleSecretID},
+		{"google_project_id", "google_secret_manager", false, "GCP project holding the secrets.", &m.GoogleProjectID},
+		{"google_credentials_json", "google_secret_manager", true, "Service account credentials JSON.", &m.GoogleCredentialsJSON},
+		{"azure_key_vault_uri", "azure_key_vault", false, "URI of the Azure Key Vault.", &m.AzureKeyVaultURI},
+		{"azure_tenant_id", "azure_key_vault", false, "Azure AD tenant ID.", &m.AzureTenantID},
+		{"azure_client_id", "azure_key_vault", false, "Azure AD application (client) ID.", &m.AzureClientID},
+		{"azure_client_secret", "azure_key_vault", true, "Azure AD client secret.", &m.AzureClientSecret},
+	}
+}
+
+// secretManagerRequiredFields lists the attributes each backend cannot work without.
+var secretManagerRequiredFields = map[string][]string{
+	"aws_secret_manager":    {"aws_region_name"},
+	"hashicorp_vault":       {"vault_addr"},
+	"google_secret_manager": {"google_project_id"},
+	"azure_key_vault":       {"azure_key_vault_uri"},
+}
+
+func secretManagerSettingsBlock() schema.SingleNestedBlock {
+	attributes := map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			Description: "Secret manager backend. Valid values: " + strings.Join(secretManagerTypes, ", ") + ".",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(secretManagerTypes...),
+			},
+		},
+		"store_virtual_keys": schema.BoolAttribute{
+			Description: "Whether the team's virtual keys are written to the secret manager.",
+			Optional:    true,
+		},
+		"prefix_for_stored_virtual_keys": schema.StringAttribute{
+			Description: "Prefix for the names of stored virtual keys.",
+			Optional:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "Description attached to stored secrets.",
+			Optional:    true,
+		},
+	}
+
+	for _, field := range (&SecretManagerSettingsModel{}).backendFields() {
+		attributes[field.name] = schema.StringAttribute{
+			Description: field.description,
+			Optional:    true,
+			Sensitive:   field.sensitive,
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Description: "Secret manager the team's virtual keys are stored in. Secret values are masked by the proxy, so they are kept from configuration rather than read back.",
+		Attributes:  attributes,
+	}
+}
+
+// validateSecretManagerSettings checks that the block only sets attributes for
+// its own backend and sets the ones that backend requires.
+func validateSecretManagerSettings(sm *SecretManagerSettingsModel, root path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if sm.Type.IsUnknown() {
+		return diags
+	}
+	if sm.Type.IsNull() {
+		diags.AddAttributeError(
+			root.AtName("type"),
+			"Missing Secret Manager Type",
+			"type must be set in secret_manager_settings.",
+		)
+		return diags
+	}
+
+	backend := sm.Type.ValueString()
+	set := map[string]bool{}
+	for _, field := range sm.backendFields() {
+		if field.value.IsNull() {
+			continue
+		}
+		set[field.name] = true
+		if field.backend != backend {
+			diags.AddAttributeError(
+				root.AtName(field.name),
+				"Invalid Secret Manager Attribute",
+				fmt.Sprintf("%s can only be used with type %q, not %q.", field.name, field.backend, backend),
+			)
+		}
+	}
+
+	for _, name := range secretManagerRequiredFields[backend] {
+		if !set[name] {
+			diags.AddAttributeError(
+				root.AtName(name),
+				"Missing Secret Manager Attribute",
+				fmt.Sprintf("%s is required when type is %q.", name, backend),
+			)
+		}
+	}
+
+	if backend == "hashicorp_vault" {
+		approle := set["vault_approle_role_id"] || set["vault_approle_secret_id"]
+		if set["vault_token"] && approle {
+			diags.AddAttributeError(
+				root.AtName("vault_token"),
+				"Conflicting Vault Credentials",
+				"Set either vault_token or the AppRole credentials, not both.",
+			)
+		}
+		if approle && !(set["vault_approle_role_id"] && set["vault_approle_secret_id"]) {
+			diags.AddAttributeError(
+				root.AtName("vault_approle_role_id"),
+				"Incomplete Vault AppRole Credentials",
+				"vault_approle_role_id and vault_approle_secret_id must be set together.",
+			)
+		}
+	}
+
+	return diags
+}
+
+func buildSecretManagerSettings(sm *SecretManagerSettingsModel) map[string]interface{} {
+	settings := map[string]interface{}{}
+
+	if !sm.Type.IsNull() && sm.Type.ValueString() != "" {
+		settings["key_management_system"] = sm.Type.ValueString()
+	}
+	if !sm.StoreVirtualKeys.IsNull() {
+		settings["store_virtual_keys"] = sm.StoreVirtualKeys.ValueBool()
+	}
+	if !sm.PrefixForStoredVirtualKeys.IsNull() && sm.PrefixForStoredVirtualKeys.ValueString() != "" {
+		settings["prefix_for_stored_virtual_keys"] = sm.PrefixForStoredVirtualKeys.ValueString()
+	}
+	if !sm.Description.IsNull() && sm.Description.ValueString() != "" {
+		settings["description"] = sm.Description.ValueString()
+	}
+
+	for _, field := range sm.backendFields() {
+		if !field.value.IsNull() && field.value.ValueString() != "" {
+			settings[field.name] = field.value.ValueString()
+		}
+	}
+
+	return settings
+}
+
+// findSecretManagerSettings returns the secret_manager_settings from a team info
+// response, looking at the top level first and then inside team_info.
+func findSecretManagerSettings(result map[string]interface{}) map[string]interface{} {
+	if sm, ok := result["secret_manager_settings"].(map[string]interface{}); ok {
+		return sm
+	}
+	if teamInfo, ok := result["team_info"].(map[string]interface{}); ok {
+		if sm, ok := teamInfo["secret_manager_settings"].(map[string]interface{}); ok {
+			return sm
+		}
+	}
+	return nil
+}
+
+// readSecretManagerSettings maps the API response onto the block. The proxy
+// masks secret values, so sensitive attributes always keep their prior value.
+func readSecretManagerSettings(raw map[string]interface{}, current *SecretManagerSettingsModel) *SecretManagerSettingsModel {
+	if raw == nil {
+		return current
+	}
+	if current == nil && len(raw) == 0 {
+		return nil
+	}
+
+	sm := &SecretManagerSettingsModel{}
+	if current != nil {
+		*sm = *current
+	} else {
+		sm.Type = types.StringNull()
+		sm.StoreVirtualKeys = types.BoolNull()
+		sm.PrefixForStoredVirtualKeys = types.StringNull()
+		sm.Description = types.StringNull()
+		for _, field := range sm.backendFields() {
+			*field.value = types.StringNull()
+		}
+	}
+
+	if backend, ok := raw["key_management_system"].(string); ok && backend != "" {
+		sm.Type = types.StringValue(backend)
+	}
+	if store, ok := raw["store_virtual_keys"].(bool); ok && (store || !sm.StoreVirtualKeys.IsNull()) {
+		sm.StoreVirtualKeys = types.BoolValue(store)
+	}
+	if prefix, ok := raw["prefix_for_stored_virtual_keys"].(string); ok && prefix != "" {
+		sm.PrefixForStoredVirtualKeys = types.StringValue(prefix)
+	}
+	if description, ok := raw["description"].(string); ok && description != "" {
+		sm.Description = types.StringValue(description)
+	}
+
+	for _, field := range sm.backendFields() {
+		if field.sensitive {
+			continue
+		}
+		if v, ok := raw[field.name].(string); ok && v != "" {
+			*field.value = types.StringValue(v)
+		}
+	}
+
+	return sm
+}
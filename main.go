@@ -2,8 +2,11 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/nicholas-cecere/terraform-provider-litellm/internal/provider"
@@ -13,6 +16,13 @@ import (
 var version string = "dev"
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := runExport(context.Background(), os.Args[2:]); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -28,3 +38,41 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// runExport writes the objects of a running proxy as Terraform configuration,
+// e.g. terraform-provider-litellm export --api-base https://litellm.example.com --out imported/
+func runExport(ctx context.Context, args []string) error {
+	var opts provider.ExportOptions
+
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	flags.StringVar(&opts.APIBase, "api-base", "", "base URL of the LiteLLM proxy (default $LITELLM_API_BASE)")
+	flags.StringVar(&opts.APIKey, "api-key", "", "API key for the LiteLLM proxy (default $LITELLM_API_KEY)")
+	flags.BoolVar(&opts.InsecureSkipVerify, "insecure-skip-verify", false, "skip TLS certificate verification")
+	flags.StringVar(&opts.OutDir, "out", ".", "directory to write the configuration to")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if opts.APIBase == "" {
+		opts.APIBase = os.Getenv("LITELLM_API_BASE")
+	}
+	if opts.APIKey == "" {
+		opts.APIKey = os.Getenv("LITELLM_API_KEY")
+	}
+	if opts.APIBase == "" || opts.APIKey == "" {
+		return errors.New("set --api-base and --api-key, or the LITELLM_API_BASE and LITELLM_API_KEY environment variables")
+	}
+
+	result, err := provider.Export(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	for _, warning := range result.Warnings {
+		log.Printf("warning: %s", warning)
+	}
+	for _, file := range result.Files {
+		fmt.Printf("wrote %s\n", file)
+	}
+	return nil
+}